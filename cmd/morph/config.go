@@ -0,0 +1,120 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/freerware/morph"
+)
+
+// runConfig runs the config command, which generates a configuration from the struct
+// types of a Go package using the same naming rules as morph.Reflect.
+func runConfig(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("config", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: morph config [flags] -types T1,T2 <package>")
+		fmt.Fprintln(fs.Output())
+		fmt.Fprintln(fs.Output(), "Generates a configuration from the struct types of a Go package, without running it.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+
+	var (
+		typeNames = fs.String("types", "", "comma separated names of the struct types to map (required)")
+		output    = fs.String("o", "", "the file to write the configuration to (default standard output)")
+		format    = fs.String("format", "", "the format of the configuration, yaml or json (default from the -o extension, or yaml)")
+		options   = reflectFlags(fs)
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 || strings.TrimSpace(*typeNames) == "" {
+		fs.Usage()
+		return errors.New("morph: a package and at least one type are required")
+	}
+
+	if *format == "" {
+		*format = "yaml"
+		if _, ok := morph.Writers[extension(*output)]; ok {
+			*format = extension(*output)
+		}
+	}
+	writer, ok := morph.Writers[*format]
+	if !ok {
+		return fmt.Errorf("morph: unsupported format %q", *format)
+	}
+
+	tables, err := tablesFor(".", fs.Arg(0), split(*typeNames), options()...)
+	if err != nil {
+		return err
+	}
+
+	var c morph.Configuration
+	c.FromTables(tables...)
+
+	if *output == "" {
+		return writer.Write(stdout, c)
+	}
+	f, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+	if err := writer.Write(f, c); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// reflectFlags defines the flags mirroring the reflection options on the provided flag set,
+// and retrieves a function that constructs the reflection options from their values.
+func reflectFlags(fs *flag.FlagSet) func() []morph.ReflectOption {
+	var (
+		tag            = fs.String("tag", "", "the struct tag containing column names")
+		tableCase      = fs.String("table-case", string(morph.DefaultTableNameStrategy), "the case of table names: snake, screaming_snake or camel")
+		singular       = fs.Bool("singular", false, "use singular table names")
+		aliasCase      = fs.String("alias-case", string(morph.DefaultTableAliasStrategy), "the case of table aliases: upper or lower")
+		aliasLength    = fs.Int("alias-length", morph.DefaultTableAliasLength, "the length of table aliases")
+		columnCase     = fs.String("column-case", string(morph.DefaultColumnNameStrategy), "the case of column names: snake, screaming_snake, camel, upper or lower")
+		excludeMethods = fs.String("exclude-methods", "^Set.*", "a regular expression matching methods to exclude")
+		excludeFields  = fs.String("exclude-fields", "", "a regular expression matching fields to exclude")
+		primaryKeys    = fs.String("primary-key", "id", "comma separated names of the primary key columns")
+	)
+
+	return func() []morph.ReflectOption {
+		options := []morph.ReflectOption{
+			morph.WithInferredTableName(morph.CaseStrategy(*tableCase), !*singular),
+			morph.WithInferredTableAlias(morph.CaseStrategy(*aliasCase), *aliasLength),
+			morph.WithInferredColumnNames(morph.CaseStrategy(*columnCase)),
+			morph.WithoutMatchingMethods(*excludeMethods),
+			morph.WithoutMatchingFields(*excludeFields),
+			morph.WithPrimaryKeyColumns(split(*primaryKeys)...),
+		}
+		if *tag != "" {
+			options = append(options, morph.WithTag(*tag))
+		}
+		return options
+	}
+}
+
+// split splits the provided comma separated list, ignoring empty entries.
+func split(list string) []string {
+	var values []string
+	for _, v := range strings.Split(list, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// extension retrieves the lowercase file extension for the provided path, without the leading dot.
+func extension(path string) string {
+	return strings.ToLower(strings.TrimPrefix(filepath.Ext(path), "."))
+}
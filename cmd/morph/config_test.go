@@ -0,0 +1,185 @@
+package main
+
+import (
+	"bytes"
+	"go/types"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/freerware/morph"
+	"github.com/freerware/morph/cmd/morph/internal/example"
+	"github.com/stretchr/testify/suite"
+)
+
+type ConfigTestSuite struct {
+	suite.Suite
+
+	pkg    *types.Package
+	stdout *bytes.Buffer
+	stderr *bytes.Buffer
+}
+
+func TestConfigTestSuite(t *testing.T) {
+	suite.Run(t, new(ConfigTestSuite))
+}
+
+func (s *ConfigTestSuite) SetupSuite() {
+	pkg, err := loadPackage(".", "./internal/example")
+	s.Require().NoError(err)
+	s.pkg = pkg
+}
+
+func (s *ConfigTestSuite) SetupTest() {
+	s.stdout, s.stderr = &bytes.Buffer{}, &bytes.Buffer{}
+}
+
+func (s *ConfigTestSuite) TestTablesIn_MatchesReflect() {
+	tests := []struct {
+		name    string
+		options []morph.ReflectOption
+	}{
+		{name: "Defaults"},
+		{name: "Tag", options: []morph.ReflectOption{morph.WithTag("morph")}},
+		{
+			name: "Strategies",
+			options: []morph.ReflectOption{
+				morph.WithInferredTableName(morph.ScreamingSnakeCaseStrategy, false),
+				morph.WithInferredTableAlias(morph.LowerCaseStrategy, 3),
+				morph.WithInferredColumnNames(morph.CamelCaseStrategy),
+			},
+		},
+		{
+			name: "Exclusions",
+			options: []morph.ReflectOption{
+				morph.WithoutMatchingMethods("^(Set|Given).*"),
+				morph.WithoutMatchingFields("^(Avatar|Roles)$"),
+				morph.WithColumnNameMapping("CreatedAt", "created"),
+				morph.WithPrimaryKeyColumns("id", "username"),
+			},
+		},
+		{name: "ExplicitNames", options: []morph.ReflectOption{morph.WithTableName("people"), morph.WithTableAlias("P")}},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// arrange.
+			user, err := morph.Reflect(example.User{}, test.options...)
+			s.Require().NoError(err)
+			order, err := morph.Reflect(example.Order{}, test.options...)
+			s.Require().NoError(err)
+
+			// action.
+			tables, err := tablesIn(s.pkg, []string{"User", "Order"}, test.options...)
+
+			// assert.
+			s.Require().NoError(err)
+			s.Require().Len(tables, 2)
+			s.Equal(user.AsConfiguration(), tables[0].AsConfiguration())
+			s.Equal(order.AsConfiguration(), tables[1].AsConfiguration())
+		})
+	}
+}
+
+func (s *ConfigTestSuite) TestTablesIn_MissingType() {
+	// action.
+	_, err := tablesIn(s.pkg, []string{"Missing"})
+
+	// assert.
+	s.Require().EqualError(err, `morph: no type "Missing" in package "github.com/freerware/morph/cmd/morph/internal/example"`)
+}
+
+func (s *ConfigTestSuite) TestTablesIn_NotStruct() {
+	// action.
+	_, err := tablesIn(s.pkg, []string{"Status"})
+
+	// assert.
+	s.Require().EqualError(err, `morph: type "Status" in package "github.com/freerware/morph/cmd/morph/internal/example" is not a struct`)
+}
+
+func (s *ConfigTestSuite) TestLoadPackage_Missing() {
+	// action.
+	_, err := loadPackage(".", "./internal/missing")
+
+	// assert.
+	s.Require().Error(err)
+}
+
+func (s *ConfigTestSuite) TestRunConfig() {
+	// arrange.
+	args := []string{"config", "-types", "Order", "-primary-key", "order_id,line_item", "-singular", "./internal/example"}
+
+	// action.
+	err := run(args, s.stdout, s.stderr)
+
+	// assert.
+	s.Require().NoError(err)
+	c, err := morph.LoadReader(s.stdout, "yaml")
+	s.Require().NoError(err)
+	s.Require().NoError(c.Validate())
+	s.Require().Len(c.Tables, 1)
+	s.Equal("example.Order", c.Tables[0].TypeName)
+	s.Equal("order", c.Tables[0].Name)
+	s.Equal("O", c.Tables[0].Alias)
+	s.Equal(morph.ColumnConfiguration{
+		Name:          "line_item",
+		Field:         "LineItem",
+		FieldType:     "int",
+		FieldStrategy: morph.FieldStrategyStructField,
+		PrimaryKey:    true,
+	}, c.Tables[0].Columns[0])
+}
+
+func (s *ConfigTestSuite) TestRunConfig_OutputFile() {
+	// arrange.
+	path := filepath.Join(s.T().TempDir(), "users.json")
+	args := []string{"config", "-types", "User", "-tag", "morph", "-o", path, "./internal/example"}
+
+	// action.
+	err := run(args, s.stdout, s.stderr)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Empty(s.stdout.String())
+	data, err := os.ReadFile(path)
+	s.Require().NoError(err)
+	s.NoError(morph.ValidateSchema(data, "json"))
+	c, err := morph.Load(path)
+	s.Require().NoError(err)
+	s.Equal("users", c.Tables[0].Name)
+}
+
+func (s *ConfigTestSuite) TestRunConfig_Errors() {
+	tests := []struct {
+		name string
+		args []string
+		err  string
+	}{
+		{name: "MissingTypes", args: []string{"config", "./internal/example"}, err: "morph: a package and at least one type are required"},
+		{name: "MissingPackage", args: []string{"config", "-types", "User"}, err: "morph: a package and at least one type are required"},
+		{name: "UnknownFlag", args: []string{"config", "-unknown"}, err: "flag provided but not defined: -unknown"},
+		{name: "UnsupportedFormat", args: []string{"config", "-types", "User", "-format", "xml", "./internal/example"}, err: `morph: unsupported format "xml"`},
+		{name: "MissingType", args: []string{"config", "-types", "Missing", "./internal/example"}, err: `morph: no type "Missing" in package "github.com/freerware/morph/cmd/morph/internal/example"`},
+		{name: "InvalidMethodPattern", args: []string{"config", "-types", "User", "-exclude-methods", "(", "./internal/example"}, err: "morph: invalid method exclusion pattern \"(\": error parsing regexp: missing closing ): `(`"},
+		{name: "InvalidFieldPattern", args: []string{"config", "-types", "User", "-exclude-fields", "[", "./internal/example"}, err: "morph: invalid field exclusion pattern \"[\": error parsing regexp: missing closing ]: `[`"},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// action.
+			err := run(test.args, s.stdout, s.stderr)
+
+			// assert.
+			s.Require().EqualError(err, test.err)
+		})
+	}
+}
+
+func (s *ConfigTestSuite) TestRun_UnknownCommand() {
+	// action.
+	err := run([]string{"unknown"}, s.stdout, s.stderr)
+
+	// assert.
+	s.Require().EqualError(err, `morph: unknown command "unknown"`)
+	s.Contains(s.stderr.String(), "config")
+}
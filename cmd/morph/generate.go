@@ -0,0 +1,489 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/freerware/morph"
+	"github.com/iancoleman/strcase"
+)
+
+// runGenerate runs the generate command, which generates reflection-free mappers for the
+// struct types of a Go package. It is intended to be run by go generate from the directory
+// of the package, such as:
+//
+//	//go:generate go run github.com/freerware/morph/cmd/morph generate -types User
+func runGenerate(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("generate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: morph generate [flags] [package]")
+		fmt.Fprintln(fs.Output())
+		fmt.Fprintln(fs.Output(), "Generates mappers that evaluate, scan and query the struct types of a Go package without reflection.")
+		fmt.Fprintln(fs.Output(), "The types are mapped using the configurations provided, or otherwise using the same rules as morph.Reflect.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+
+	var configs pathList
+	fs.Var(&configs, "config", "comma separated configuration files or directories mapping the types, which may be repeated")
+	var (
+		typeNames    = fs.String("types", "", "comma separated names of the struct types to map (default every type of the package within the configuration)")
+		output       = fs.String("o", "morph_gen.go", "the file to write the mappers to")
+		dialectNames = fs.String("dialects", strings.Join(dialects(), ","), "comma separated SQL dialects to precompute statements for")
+		options      = reflectFlags(fs)
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 1 || (len(configs) == 0 && strings.TrimSpace(*typeNames) == "") {
+		fs.Usage()
+		return errors.New("morph: at most one package and at least one type or configuration are required")
+	}
+	pattern := "."
+	if fs.NArg() == 1 {
+		pattern = fs.Arg(0)
+	}
+
+	names := split(*dialectNames)
+	for _, name := range names {
+		if _, ok := morph.Dialects[name]; !ok {
+			return fmt.Errorf("morph: unsupported dialect %q", name)
+		}
+	}
+
+	pkg, err := loadPackage(".", pattern)
+	if err != nil {
+		return err
+	}
+
+	var tables []morph.Table
+	if len(configs) > 0 {
+		tables, err = configuredTables(pkg, configs, split(*typeNames))
+	} else {
+		tables, err = tablesIn(pkg, split(*typeNames), options()...)
+	}
+	if err != nil {
+		return err
+	}
+
+	src, err := generate(pkg, tables, names)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(*output, src, 0o644)
+}
+
+// configuredTables retrieves the tables within the provided configurations for the struct
+// types of the provided package with the provided names, or for all of its struct types
+// when no names are provided.
+func configuredTables(pkg *types.Package, configs, names []string) ([]morph.Table, error) {
+	c, err := loadConfiguration(configs)
+	if err != nil {
+		return nil, err
+	}
+	all, err := c.AsMetadataStrict()
+	if err != nil {
+		return nil, err
+	}
+
+	byType := make(map[string]morph.Table)
+	var found []string
+	for _, t := range all {
+		typeName := strings.TrimPrefix(t.TypeName(), "*")
+		if name, ok := strings.CutPrefix(typeName, pkg.Name()+"."); ok {
+			byType[name] = t
+			found = append(found, name)
+		}
+	}
+
+	if len(names) == 0 {
+		if len(found) == 0 {
+			return nil, fmt.Errorf("morph: no tables for the types of package %q in the configuration", pkg.Path())
+		}
+		names = found
+	}
+
+	tables := make([]morph.Table, 0, len(names))
+	for _, name := range names {
+		t, ok := byType[name]
+		if !ok {
+			return nil, fmt.Errorf("morph: no table for type %q in the configuration", name)
+		}
+		tables = append(tables, t)
+	}
+	return tables, nil
+}
+
+// mapper represents the generated mapper for a struct type.
+type mapper struct {
+	Type           string
+	Table          morph.Table
+	Columns        []mapped
+	PrimaryKeys    []int
+	NonPrimaryKeys []int
+	Statements     []statements
+}
+
+// Unexported retrieves the name of the unexported mapper type.
+func (m mapper) Unexported() string {
+	return strcase.ToLowerCamel(m.Type) + "Mapper"
+}
+
+// Nilable retrieves the columns with values that may be nil pointers.
+func (m mapper) Nilable() []mapped {
+	var columns []mapped
+	for _, c := range m.Columns {
+		if c.Nilable {
+			columns = append(columns, c)
+		}
+	}
+	return columns
+}
+
+// Scanned retrieves the columns scanned into local variables rather than fields.
+func (m mapper) Scanned() []mapped {
+	var columns []mapped
+	for _, c := range m.Columns {
+		if c.UsingMethodStrategy() {
+			columns = append(columns, c)
+		}
+	}
+	return columns
+}
+
+// mapped represents a column of a generated mapper.
+type mapped struct {
+	morph.Column
+
+	// Var is the name of the local variable holding the value of the column.
+	Var string
+
+	// Access is the expression retrieving the value of the column from the entity.
+	Access string
+
+	// Nilable indicates if the value may be a nil pointer, and Deref if the value
+	// is dereferenced when it is not.
+	Nilable bool
+	Deref   bool
+
+	// ScanType is the type of the local variable a method column is scanned into, and
+	// Setter the method that the scanned value is provided to, if any.
+	ScanType string
+	Setter   string
+}
+
+// Value retrieves the expression for the value of the column when evaluating.
+func (c mapped) Value() string {
+	if c.Nilable {
+		return c.Var
+	}
+	return c.Access
+}
+
+// Dest retrieves the expression for the destination of the column when scanning.
+func (c mapped) Dest() string {
+	if c.UsingMethodStrategy() {
+		return "&" + c.Var
+	}
+	return "&" + c.Access
+}
+
+// statements represents the statements for a table in a single dialect.
+type statements struct {
+	Dialect string
+	morph.Statements
+}
+
+// generate generates the formatted source of the mappers for the provided tables, whose
+// types belong to the provided package, with statements precomputed for the provided dialects.
+func generate(pkg *types.Package, tables []morph.Table, dialects []string) ([]byte, error) {
+	imports := map[string]bool{"github.com/freerware/morph": true}
+	qualifier := func(p *types.Package) string {
+		if p == pkg {
+			return ""
+		}
+		imports[p.Path()] = true
+		return p.Name()
+	}
+
+	sort.Strings(dialects)
+	mappers := make([]mapper, 0, len(tables))
+	for _, t := range tables {
+		m, err := mapperFor(pkg, t, dialects, qualifier)
+		if err != nil {
+			return nil, err
+		}
+		mappers = append(mappers, m)
+	}
+
+	paths := make([]string, 0, len(imports))
+	for path := range imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	data := struct {
+		Package string
+		Imports []string
+		Mappers []mapper
+	}{pkg.Name(), paths, mappers}
+
+	var buf bytes.Buffer
+	if err := generateTmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("morph: unable to format generated source: %w", err)
+	}
+	return src, nil
+}
+
+// mapperFor constructs the generated mapper for the provided table, whose type belongs
+// to the provided package.
+func mapperFor(pkg *types.Package, t morph.Table, dialects []string, qualifier types.Qualifier) (mapper, error) {
+	typeName := strings.TrimPrefix(strings.TrimPrefix(t.TypeName(), "*"), pkg.Name()+".")
+	obj, ok := pkg.Scope().Lookup(typeName).(*types.TypeName)
+	if !ok {
+		return mapper{}, fmt.Errorf("morph: no type %q in package %q", typeName, pkg.Path())
+	}
+	named, ok := obj.Type().(*types.Named)
+	if !ok || !isStruct(named) {
+		return mapper{}, fmt.Errorf("morph: type %q in package %q is not a struct", typeName, pkg.Path())
+	}
+
+	m := mapper{Type: typeName, Table: t}
+	vars := map[string]bool{"e": true, "v": true, "row": true, "err": true, "values": true}
+	for i, col := range t.Columns() {
+		c := mapped{Column: col, Var: localName(col.Field(), vars)}
+		target, _, _ := types.LookupFieldOrMethod(named, true, pkg, col.Field())
+
+		if col.UsingStructFieldStrategy() {
+			field, ok := target.(*types.Var)
+			if !ok || !field.IsField() {
+				return mapper{}, fmt.Errorf("morph: type %q has no field %q", typeName, col.Field())
+			}
+			c.Access = "e." + col.Field()
+			_, c.Nilable = field.Type().Underlying().(*types.Pointer)
+			c.Deref = c.Nilable
+		} else {
+			method, ok := target.(*types.Func)
+			if !ok {
+				return mapper{}, fmt.Errorf("morph: type %q has no method %q", typeName, col.Field())
+			}
+			signature := method.Type().(*types.Signature)
+			if signature.Params().Len() != 0 || signature.Results().Len() == 0 {
+				return mapper{}, fmt.Errorf("morph: method %q of type %q must have no parameters and a result", col.Field(), typeName)
+			}
+			result := signature.Results().At(0).Type()
+			c.Access = "e." + col.Field() + "()"
+			_, c.Nilable = result.Underlying().(*types.Pointer)
+
+			// the scanned value is provided to the setter, if any, and otherwise discarded.
+			c.ScanType = "any"
+			setterName := "Set" + col.Field()
+			if setter, ok := lookupMethod(named, pkg, setterName); ok && setter.Params().Len() == 1 {
+				c.ScanType = types.TypeString(setter.Params().At(0).Type(), qualifier)
+				c.Setter = setterName
+			}
+		}
+
+		m.Columns = append(m.Columns, c)
+		if col.PrimaryKey() {
+			m.PrimaryKeys = append(m.PrimaryKeys, i)
+		} else {
+			m.NonPrimaryKeys = append(m.NonPrimaryKeys, i)
+		}
+	}
+
+	for _, dialect := range dialects {
+		s, err := t.Statements(morph.Dialects[dialect]...)
+		if err != nil {
+			return mapper{}, fmt.Errorf("morph: table %q: %w", t.Name(), err)
+		}
+		m.Statements = append(m.Statements, statements{Dialect: dialect, Statements: s})
+	}
+	return m, nil
+}
+
+// lookupMethod retrieves the signature of the method with the provided name within the
+// method set of a pointer to the provided type.
+func lookupMethod(named *types.Named, pkg *types.Package, name string) (*types.Signature, bool) {
+	target, _, _ := types.LookupFieldOrMethod(named, true, pkg, name)
+	method, ok := target.(*types.Func)
+	if !ok {
+		return nil, false
+	}
+	return method.Type().(*types.Signature), true
+}
+
+// localName retrieves a unique local variable name for the provided field, recording it
+// within the provided names already in use.
+func localName(field string, used map[string]bool) string {
+	base := strcase.ToLowerCamel(field)
+	if token.IsKeyword(base) || types.Universe.Lookup(base) != nil {
+		base += "Value"
+	}
+	name := base
+	for i := 2; used[name]; i++ {
+		name = base + strconv.Itoa(i)
+	}
+	used[name] = true
+	return name
+}
+
+// generateTmpl is the parsed template used to generate the mappers.
+var generateTmpl = template.Must(template.New("generate").Funcs(template.FuncMap{
+	"quote": strconv.Quote,
+	"strategy": func(s morph.FieldStrategy) string {
+		if s == morph.FieldStrategyMethod {
+			return "morph.FieldStrategyMethod"
+		}
+		return "morph.FieldStrategyStructField"
+	},
+}).Parse(`// Code generated by morph generate; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- range .Imports}}
+	{{quote .}}
+{{- end}}
+)
+{{range .Mappers}}{{$m := .}}
+// {{.Type}}Fields are the names of the fields and methods of {{.Type}} mapped to columns.
+var {{.Type}}Fields = struct {
+{{- range .Columns}}
+	{{.Field}} string
+{{- end}}
+}{
+{{- range .Columns}}
+	{{.Field}}: {{quote .Field}},
+{{- end}}
+}
+
+// {{.Type}}Columns are the names of the columns {{.Type}} is mapped to, by field.
+var {{.Type}}Columns = struct {
+{{- range .Columns}}
+	{{.Field}} string
+{{- end}}
+}{
+{{- range .Columns}}
+	{{.Field}}: {{quote .Name}},
+{{- end}}
+}
+
+// {{.Type}}Mapper maps {{.Type}} to the {{.Table.Name}} table without reflection.
+var {{.Type}}Mapper {{.Unexported}}
+
+// {{.Unexported}} is the type of {{.Type}}Mapper.
+type {{.Unexported}} struct{}
+
+// {{.Unexported}}Statements are the precomputed statements for {{.Type}}, keyed by dialect.
+var {{.Unexported}}Statements = map[string]morph.Statements{
+{{- range .Statements}}
+	{{quote .Dialect}}: {
+		Insert: {{quote .Insert}},
+		Update: {{quote .Update}},
+		Delete: {{quote .Delete}},
+		Select: {{quote .Select}},
+	},
+{{- end}}
+}
+
+// Table retrieves the metadata mapping {{.Type}} to the {{.Table.Name}} table.
+func ({{.Unexported}}) Table() morph.Table {
+	var table morph.Table
+	table.SetTypeName({{quote .Table.TypeName}})
+	table.SetName({{quote .Table.Name}})
+	table.SetAlias({{quote .Table.Alias}})
+	for _, c := range []struct {
+		name, field, fieldType string
+		strategy               morph.FieldStrategy
+		primaryKey             bool
+	}{
+{{- range .Columns}}
+		{ {{- quote .Name}}, {{quote .Field}}, {{quote .FieldType}}, {{strategy .Strategy}}, {{.PrimaryKey -}} },
+{{- end}}
+	} {
+		var column morph.Column
+		column.SetName(c.name)
+		column.SetField(c.field)
+		column.SetFieldType(c.fieldType)
+		column.SetStrategy(c.strategy)
+		column.SetPrimaryKey(c.primaryKey)
+		if err := table.AddColumn(column); err != nil {
+			panic(err)
+		}
+	}
+	return table
+}
+
+// Statements retrieves the statements for {{.Type}} in the provided dialect, if they
+// were precomputed.
+func ({{.Unexported}}) Statements(dialect string) (morph.Statements, bool) {
+	s, ok := {{.Unexported}}Statements[dialect]
+	return s, ok
+}
+
+// Evaluate retrieves the values of the columns of the provided {{.Type}} in column order,
+// which are the arguments of the INSERT statement.
+func ({{.Unexported}}) Evaluate(e *{{.Type}}) []any {
+{{- range .Nilable}}
+	var {{.Var}} any
+	if v := {{.Access}}; v != nil {
+		{{.Var}} = {{if .Deref}}*{{end}}v
+	}
+{{- end}}
+	return []any{
+{{- range .Columns}}
+		{{.Value}},
+{{- end}}
+	}
+}
+
+// UpdateArgs retrieves the arguments of the UPDATE statement for the provided {{.Type}}.
+func (m {{.Unexported}}) UpdateArgs(e *{{.Type}}) []any {
+	values := m.Evaluate(e)
+	return []any{ {{- range .NonPrimaryKeys}}values[{{.}}], {{end}}{{range .PrimaryKeys}}values[{{.}}], {{end -}} }
+}
+
+// KeyArgs retrieves the arguments of the DELETE and SELECT statements for the provided {{.Type}}.
+func (m {{.Unexported}}) KeyArgs(e *{{.Type}}) []any {
+	values := m.Evaluate(e)
+	return []any{ {{- range .PrimaryKeys}}values[{{.}}], {{end -}} }
+}
+
+// Scan copies the columns of a row retrieved by the SELECT statement into the provided {{.Type}}.
+{{- range .Scanned}}{{if not .Setter}}
+// The value of {{.Field}} is discarded, since {{$m.Type}} has no Set{{.Field}} method.
+{{- end}}{{end}}
+func ({{.Unexported}}) Scan(row interface{ Scan(dest ...any) error }, e *{{.Type}}) error {
+{{- range .Scanned}}
+	var {{.Var}} {{.ScanType}}
+{{- end}}
+	if err := row.Scan(
+{{- range .Columns}}
+		{{.Dest}},
+{{- end}}
+	); err != nil {
+		return err
+	}
+{{- range .Scanned}}{{if .Setter}}
+	e.{{.Setter}}({{.Var}})
+{{- end}}{{end}}
+	return nil
+}
+{{end}}`))
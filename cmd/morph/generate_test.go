@@ -0,0 +1,328 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/freerware/morph"
+	"github.com/freerware/morph/cmd/morph/internal/example"
+	"github.com/stretchr/testify/suite"
+)
+
+type GenerateTestSuite struct {
+	suite.Suite
+
+	pkg    *types.Package
+	user   morph.Table
+	order  morph.Table
+	stdout *bytes.Buffer
+	stderr *bytes.Buffer
+}
+
+func TestGenerateTestSuite(t *testing.T) {
+	suite.Run(t, new(GenerateTestSuite))
+}
+
+func (s *GenerateTestSuite) SetupSuite() {
+	options := []morph.ReflectOption{morph.WithTag("morph"), morph.WithPrimaryKeyColumns("id", "order_id", "line_item")}
+	s.user = morph.Must(morph.Reflect(example.User{}, options...))
+	s.order = morph.Must(morph.Reflect(example.Order{}, options...))
+
+	pkg, err := loadPackage(".", "./internal/example")
+	s.Require().NoError(err)
+	s.pkg = pkg
+}
+
+func (s *GenerateTestSuite) SetupTest() {
+	s.stdout, s.stderr = &bytes.Buffer{}, &bytes.Buffer{}
+}
+
+func (s *GenerateTestSuite) SetupSubTest() {
+	s.SetupTest()
+}
+
+func (s *GenerateTestSuite) TestRunGenerate_UpToDate() {
+	// arrange.
+	path := filepath.Join(s.T().TempDir(), "morph_gen.go")
+	args := []string{"generate", "-types", "User,Order", "-tag", "morph", "-primary-key", "id,order_id,line_item", "-o", path, "./internal/example"}
+
+	// action.
+	err := run(args, s.stdout, s.stderr)
+
+	// assert.
+	s.Require().NoError(err)
+	generated, err := os.ReadFile(path)
+	s.Require().NoError(err)
+	committed, err := os.ReadFile("internal/example/morph_gen.go")
+	s.Require().NoError(err)
+	s.Equal(string(committed), string(generated), "run go generate ./cmd/morph/internal/example")
+}
+
+func (s *GenerateTestSuite) TestRunGenerate_Configuration() {
+	// arrange.
+	path := filepath.Join(s.T().TempDir(), "morph_gen.go")
+	args := []string{"generate", "-config", "testdata/example.yaml", "-dialects", "postgres", "-o", path, "./internal/example"}
+
+	// action.
+	err := run(args, s.stdout, s.stderr)
+
+	// assert.
+	s.Require().NoError(err)
+	generated, err := os.ReadFile(path)
+	s.Require().NoError(err)
+	s.Contains(string(generated), `ID:        "account_id",`)
+	s.Contains(string(generated), `"postgres": {`)
+	s.Contains(string(generated), `Delete: "DELETE FROM accounts WHERE 1=1 AND account_id = $1;",`)
+	s.Contains(string(generated), "e.SetGivenName(givenName)")
+	s.NotContains(string(generated), `"mysql": {`)
+	s.NotContains(string(generated), "Ignored")
+	s.NotContains(string(generated), "OrderMapper")
+}
+
+func (s *GenerateTestSuite) TestRunGenerate_Errors() {
+	tests := []struct {
+		name string
+		args []string
+		err  string
+	}{
+		{name: "MissingTypes", args: []string{"generate"}, err: "morph: at most one package and at least one type or configuration are required"},
+		{name: "ManyPackages", args: []string{"generate", "-types", "User", "./internal/example", "."}, err: "morph: at most one package and at least one type or configuration are required"},
+		{name: "UnsupportedDialect", args: []string{"generate", "-types", "User", "-dialects", "oracle", "./internal/example"}, err: `morph: unsupported dialect "oracle"`},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// action.
+			err := run(test.args, s.stdout, s.stderr)
+
+			// assert.
+			s.Require().EqualError(err, test.err)
+		})
+	}
+}
+
+func (s *GenerateTestSuite) TestConfiguredTables_Errors() {
+	tests := []struct {
+		name    string
+		configs []string
+		types   []string
+		err     string
+	}{
+		{name: "MissingTable", configs: []string{"testdata/example.yaml"}, types: []string{"Order"}, err: `morph: no table for type "Order" in the configuration`},
+		{name: "MissingTables", configs: []string{"testdata/other.yaml"}, err: `morph: no tables for the types of package "github.com/freerware/morph/cmd/morph/internal/example" in the configuration`},
+		{name: "InvalidConfiguration", configs: []string{"testdata/problems.yaml"}, err: `morph: testdata/problems.yaml:23:5: table "audits": table must have at least one non-primary key column`},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// action.
+			_, err := configuredTables(s.pkg, test.configs, test.types)
+
+			// assert.
+			s.Require().EqualError(err, test.err)
+		})
+	}
+}
+
+func (s *GenerateTestSuite) TestGenerate_Errors() {
+	tests := []struct {
+		name   string
+		tables func() ([]morph.Table, error)
+		err    string
+	}{
+		{
+			name: "MissingField",
+			tables: func() ([]morph.Table, error) {
+				return configuredTables(s.pkg, []string{"testdata/missing_field.yaml"}, nil)
+			},
+			err: `morph: type "Order" has no field "Notes"`,
+		},
+		{
+			name: "MissingType",
+			tables: func() ([]morph.Table, error) {
+				t := s.order
+				t.SetTypeName("example.Missing")
+				return []morph.Table{t}, nil
+			},
+			err: `morph: no type "Missing" in package "github.com/freerware/morph/cmd/morph/internal/example"`,
+		},
+		{
+			name: "NotStruct",
+			tables: func() ([]morph.Table, error) {
+				t := s.order
+				t.SetTypeName("example.Status")
+				return []morph.Table{t}, nil
+			},
+			err: `morph: type "Status" in package "github.com/freerware/morph/cmd/morph/internal/example" is not a struct`,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// arrange.
+			tables, err := test.tables()
+			s.Require().NoError(err)
+
+			// action.
+			_, err = generate(s.pkg, tables, dialects())
+
+			// assert.
+			s.Require().EqualError(err, test.err)
+		})
+	}
+}
+
+func (s *GenerateTestSuite) TestMapper_Table() {
+	// action.
+	user, order := example.UserMapper.Table(), example.OrderMapper.Table()
+
+	// assert.
+	s.Equal(s.user.AsConfiguration(), user.AsConfiguration())
+	s.Equal(s.order.AsConfiguration(), order.AsConfiguration())
+}
+
+func (s *GenerateTestSuite) TestMapper_Fields() {
+	s.Equal("ID", example.UserFields.ID)
+	s.Equal("email_address", example.UserColumns.Email)
+	s.Equal("OrderID", example.OrderFields.OrderID)
+}
+
+func (s *GenerateTestSuite) TestMapper_Statements() {
+	for _, dialect := range dialects() {
+		s.Run(dialect, func() {
+			// action.
+			user, userOK := example.UserMapper.Statements(dialect)
+			order, orderOK := example.OrderMapper.Statements(dialect)
+
+			// assert.
+			s.Require().True(userOK)
+			s.Require().True(orderOK)
+			s.Equal(morph.Must(s.user.Statements(morph.Dialects[dialect]...)), user)
+			s.Equal(morph.Must(s.order.Statements(morph.Dialects[dialect]...)), order)
+		})
+	}
+
+	_, ok := example.UserMapper.Statements("oracle")
+	s.False(ok)
+}
+
+func (s *GenerateTestSuite) TestMapper_Evaluate() {
+	deletedAt := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	users := map[string]example.User{
+		"Empty": {},
+		"Values": {
+			ID:        7,
+			Username:  "fr33r",
+			Email:     "fr33r@example.com",
+			Avatar:    []byte{1, 2},
+			Roles:     []string{"admin"},
+			Settings:  map[string]any{"theme": "dark"},
+			CreatedAt: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+			DeletedAt: &deletedAt,
+		},
+	}
+
+	for name, user := range users {
+		s.Run(name, func() {
+			// arrange.
+			user.SetGivenName("Jon")
+			result := morph.Must(s.user.Evaluate(user))
+			var expected []any
+			for _, column := range s.user.Columns() {
+				expected = append(expected, result[column.Name()])
+			}
+			_, updateArgs, err := s.user.UpdateQueryWithArgs(user)
+			s.Require().NoError(err)
+			_, keyArgs, err := s.user.DeleteQueryWithArgs(user)
+			s.Require().NoError(err)
+
+			// action + assert.
+			s.Equal(expected, example.UserMapper.Evaluate(&user))
+			s.Equal(updateArgs, example.UserMapper.UpdateArgs(&user))
+			s.Equal(keyArgs, example.UserMapper.KeyArgs(&user))
+		})
+	}
+}
+
+func (s *GenerateTestSuite) TestMapper_Evaluate_CompositeKey() {
+	// arrange.
+	order := example.Order{OrderID: 3, LineItem: 2, Status: 1, Total: 9.5, Timestamp: time.Unix(0, 0).UTC()}
+	_, updateArgs, err := s.order.UpdateQueryWithArgs(order)
+	s.Require().NoError(err)
+	_, keyArgs, err := s.order.SelectQueryWithArgs(order)
+	s.Require().NoError(err)
+
+	// action + assert.
+	s.Equal([]any{2, int64(3), example.Status(1), time.Unix(0, 0).UTC(), 9.5}, example.OrderMapper.Evaluate(&order))
+	s.Equal(updateArgs, example.OrderMapper.UpdateArgs(&order))
+	s.Equal(keyArgs, example.OrderMapper.KeyArgs(&order))
+}
+
+func (s *GenerateTestSuite) TestMapper_Scan() {
+	// arrange.
+	deletedAt := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	expected := example.User{ID: 7, Username: "fr33r", Email: "fr33r@example.com", DeletedAt: &deletedAt}
+	expected.SetGivenName("Jon")
+	row := fakeRow(example.UserMapper.Evaluate(&expected))
+
+	// action.
+	var actual example.User
+	err := example.UserMapper.Scan(row, &actual)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal(expected, actual)
+	s.Equal("Jon", actual.GivenName())
+}
+
+func (s *GenerateTestSuite) TestMapper_Scan_Error() {
+	// arrange.
+	row := fakeRow{}
+
+	// action.
+	var actual example.Order
+	err := example.OrderMapper.Scan(row, &actual)
+
+	// assert.
+	s.Require().ErrorIs(err, errColumnCount)
+}
+
+// fakeRow is a row that scans the values it holds, converting them as database/sql would
+// for the types used in the tests.
+type fakeRow []any
+
+// errColumnCount represents an error encountered when the number of destinations does not
+// match the number of values.
+var errColumnCount = errors.New("morph: expected a destination for each value")
+
+// Scan copies the values of the row into the provided destinations.
+func (r fakeRow) Scan(dest ...any) error {
+	if len(dest) != len(r) {
+		return errColumnCount
+	}
+	for i, d := range dest {
+		target := reflect.ValueOf(d).Elem()
+		if r[i] == nil {
+			target.Set(reflect.Zero(target.Type()))
+			continue
+		}
+		value := reflect.ValueOf(r[i])
+		switch {
+		case value.Type().AssignableTo(target.Type()):
+			target.Set(value)
+		case target.Kind() == reflect.Ptr:
+			ptr := reflect.New(target.Type().Elem())
+			ptr.Elem().Set(value)
+			target.Set(ptr)
+		default:
+			target.Set(value.Convert(target.Type()))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+// Package example provides entities used to test the morph command.
+package example
+
+//go:generate go run github.com/freerware/morph/cmd/morph generate -types User,Order -tag morph -primary-key id,order_id,line_item
+
+import (
+	"time"
+)
+
+// User is an example entity mapped using fields and methods.
+type User struct {
+	ID        int    `morph:"id"`
+	Username  string `morph:"username"`
+	Email     string `morph:"email_address"`
+	Avatar    []byte
+	Roles     []string
+	Settings  map[string]any
+	Secret    string `morph:"-"`
+	Address   Address
+	CreatedAt time.Time
+	DeletedAt *time.Time
+
+	givenName string
+	surname   string
+}
+
+// GivenName retrieves the given name of the user.
+func (u *User) GivenName() string {
+	return u.givenName
+}
+
+// SetGivenName modifies the given name of the user.
+func (u *User) SetGivenName(givenName string) {
+	u.givenName = givenName
+}
+
+// Surname retrieves the surname of the user.
+func (u User) Surname() *string {
+	return &u.surname
+}
+
+// Location retrieves the address of the user.
+func (u User) Location() Address {
+	return u.Address
+}
+
+// Touch does nothing, and has no result to map.
+func (u *User) Touch() {}
+
+// Address is an example value object that is not mapped to a column.
+type Address struct {
+	Street string
+}
+
+// Status is an example type that is not a struct.
+type Status int
+
+// Order is an example entity with a composite primary key.
+type Order struct {
+	OrderID   int64
+	LineItem  int
+	Status    Status
+	Total     float64
+	Timestamp time.Time
+}
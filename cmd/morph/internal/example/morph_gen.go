@@ -0,0 +1,332 @@
+// Code generated by morph generate; DO NOT EDIT.
+
+package example
+
+import (
+	"github.com/freerware/morph"
+)
+
+// UserFields are the names of the fields and methods of User mapped to columns.
+var UserFields = struct {
+	Avatar    string
+	CreatedAt string
+	DeletedAt string
+	Email     string
+	GivenName string
+	ID        string
+	Roles     string
+	Settings  string
+	Surname   string
+	Username  string
+}{
+	Avatar:    "Avatar",
+	CreatedAt: "CreatedAt",
+	DeletedAt: "DeletedAt",
+	Email:     "Email",
+	GivenName: "GivenName",
+	ID:        "ID",
+	Roles:     "Roles",
+	Settings:  "Settings",
+	Surname:   "Surname",
+	Username:  "Username",
+}
+
+// UserColumns are the names of the columns User is mapped to, by field.
+var UserColumns = struct {
+	Avatar    string
+	CreatedAt string
+	DeletedAt string
+	Email     string
+	GivenName string
+	ID        string
+	Roles     string
+	Settings  string
+	Surname   string
+	Username  string
+}{
+	Avatar:    "avatar",
+	CreatedAt: "created_at",
+	DeletedAt: "deleted_at",
+	Email:     "email_address",
+	GivenName: "given_name",
+	ID:        "id",
+	Roles:     "roles",
+	Settings:  "settings",
+	Surname:   "surname",
+	Username:  "username",
+}
+
+// UserMapper maps User to the users table without reflection.
+var UserMapper userMapper
+
+// userMapper is the type of UserMapper.
+type userMapper struct{}
+
+// userMapperStatements are the precomputed statements for User, keyed by dialect.
+var userMapperStatements = map[string]morph.Statements{
+	"mysql": {
+		Insert: "INSERT INTO users (avatar, created_at, deleted_at, email_address, given_name, id, roles, settings, surname, username) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?);",
+		Update: "UPDATE users AS U SET U.avatar = ?, U.created_at = ?, U.deleted_at = ?, U.email_address = ?, U.given_name = ?, U.roles = ?, U.settings = ?, U.surname = ?, U.username = ? WHERE 1=1 AND U.id = ?;",
+		Delete: "DELETE FROM users WHERE 1=1 AND id = ?;",
+		Select: "SELECT U.avatar, U.created_at, U.deleted_at, U.email_address, U.given_name, U.id, U.roles, U.settings, U.surname, U.username FROM users AS U WHERE 1=1 AND U.id = ?;",
+	},
+	"postgres": {
+		Insert: "INSERT INTO users (avatar, created_at, deleted_at, email_address, given_name, id, roles, settings, surname, username) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);",
+		Update: "UPDATE users AS U SET avatar = $1, created_at = $2, deleted_at = $3, email_address = $4, given_name = $5, roles = $6, settings = $7, surname = $8, username = $9 WHERE 1=1 AND U.id = $10;",
+		Delete: "DELETE FROM users WHERE 1=1 AND id = $1;",
+		Select: "SELECT U.avatar, U.created_at, U.deleted_at, U.email_address, U.given_name, U.id, U.roles, U.settings, U.surname, U.username FROM users AS U WHERE 1=1 AND U.id = $1;",
+	},
+	"sqlite": {
+		Insert: "INSERT INTO users (avatar, created_at, deleted_at, email_address, given_name, id, roles, settings, surname, username) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?);",
+		Update: "UPDATE users AS U SET avatar = ?, created_at = ?, deleted_at = ?, email_address = ?, given_name = ?, roles = ?, settings = ?, surname = ?, username = ? WHERE 1=1 AND U.id = ?;",
+		Delete: "DELETE FROM users WHERE 1=1 AND id = ?;",
+		Select: "SELECT U.avatar, U.created_at, U.deleted_at, U.email_address, U.given_name, U.id, U.roles, U.settings, U.surname, U.username FROM users AS U WHERE 1=1 AND U.id = ?;",
+	},
+	"sqlserver": {
+		Insert: "INSERT INTO users (avatar, created_at, deleted_at, email_address, given_name, id, roles, settings, surname, username) VALUES (@p1, @p2, @p3, @p4, @p5, @p6, @p7, @p8, @p9, @p10);",
+		Update: "UPDATE users AS U SET U.avatar = @p1, U.created_at = @p2, U.deleted_at = @p3, U.email_address = @p4, U.given_name = @p5, U.roles = @p6, U.settings = @p7, U.surname = @p8, U.username = @p9 WHERE 1=1 AND U.id = @p10;",
+		Delete: "DELETE FROM users WHERE 1=1 AND id = @p1;",
+		Select: "SELECT U.avatar, U.created_at, U.deleted_at, U.email_address, U.given_name, U.id, U.roles, U.settings, U.surname, U.username FROM users AS U WHERE 1=1 AND U.id = @p1;",
+	},
+}
+
+// Table retrieves the metadata mapping User to the users table.
+func (userMapper) Table() morph.Table {
+	var table morph.Table
+	table.SetTypeName("example.User")
+	table.SetName("users")
+	table.SetAlias("U")
+	for _, c := range []struct {
+		name, field, fieldType string
+		strategy               morph.FieldStrategy
+		primaryKey             bool
+	}{
+		{"avatar", "Avatar", "[]uint8", morph.FieldStrategyStructField, false},
+		{"created_at", "CreatedAt", "time.Time", morph.FieldStrategyStructField, false},
+		{"deleted_at", "DeletedAt", "*time.Time", morph.FieldStrategyStructField, false},
+		{"email_address", "Email", "string", morph.FieldStrategyStructField, false},
+		{"given_name", "GivenName", "string", morph.FieldStrategyMethod, false},
+		{"id", "ID", "int", morph.FieldStrategyStructField, true},
+		{"roles", "Roles", "[]string", morph.FieldStrategyStructField, false},
+		{"settings", "Settings", "map[string]interface {}", morph.FieldStrategyStructField, false},
+		{"surname", "Surname", "*string", morph.FieldStrategyMethod, false},
+		{"username", "Username", "string", morph.FieldStrategyStructField, false},
+	} {
+		var column morph.Column
+		column.SetName(c.name)
+		column.SetField(c.field)
+		column.SetFieldType(c.fieldType)
+		column.SetStrategy(c.strategy)
+		column.SetPrimaryKey(c.primaryKey)
+		if err := table.AddColumn(column); err != nil {
+			panic(err)
+		}
+	}
+	return table
+}
+
+// Statements retrieves the statements for User in the provided dialect, if they
+// were precomputed.
+func (userMapper) Statements(dialect string) (morph.Statements, bool) {
+	s, ok := userMapperStatements[dialect]
+	return s, ok
+}
+
+// Evaluate retrieves the values of the columns of the provided User in column order,
+// which are the arguments of the INSERT statement.
+func (userMapper) Evaluate(e *User) []any {
+	var deletedAt any
+	if v := e.DeletedAt; v != nil {
+		deletedAt = *v
+	}
+	var surname any
+	if v := e.Surname(); v != nil {
+		surname = v
+	}
+	return []any{
+		e.Avatar,
+		e.CreatedAt,
+		deletedAt,
+		e.Email,
+		e.GivenName(),
+		e.ID,
+		e.Roles,
+		e.Settings,
+		surname,
+		e.Username,
+	}
+}
+
+// UpdateArgs retrieves the arguments of the UPDATE statement for the provided User.
+func (m userMapper) UpdateArgs(e *User) []any {
+	values := m.Evaluate(e)
+	return []any{values[0], values[1], values[2], values[3], values[4], values[6], values[7], values[8], values[9], values[5]}
+}
+
+// KeyArgs retrieves the arguments of the DELETE and SELECT statements for the provided User.
+func (m userMapper) KeyArgs(e *User) []any {
+	values := m.Evaluate(e)
+	return []any{values[5]}
+}
+
+// Scan copies the columns of a row retrieved by the SELECT statement into the provided User.
+// The value of Surname is discarded, since User has no SetSurname method.
+func (userMapper) Scan(row interface{ Scan(dest ...any) error }, e *User) error {
+	var givenName string
+	var surname any
+	if err := row.Scan(
+		&e.Avatar,
+		&e.CreatedAt,
+		&e.DeletedAt,
+		&e.Email,
+		&givenName,
+		&e.ID,
+		&e.Roles,
+		&e.Settings,
+		&surname,
+		&e.Username,
+	); err != nil {
+		return err
+	}
+	e.SetGivenName(givenName)
+	return nil
+}
+
+// OrderFields are the names of the fields and methods of Order mapped to columns.
+var OrderFields = struct {
+	LineItem  string
+	OrderID   string
+	Status    string
+	Timestamp string
+	Total     string
+}{
+	LineItem:  "LineItem",
+	OrderID:   "OrderID",
+	Status:    "Status",
+	Timestamp: "Timestamp",
+	Total:     "Total",
+}
+
+// OrderColumns are the names of the columns Order is mapped to, by field.
+var OrderColumns = struct {
+	LineItem  string
+	OrderID   string
+	Status    string
+	Timestamp string
+	Total     string
+}{
+	LineItem:  "line_item",
+	OrderID:   "order_id",
+	Status:    "status",
+	Timestamp: "timestamp",
+	Total:     "total",
+}
+
+// OrderMapper maps Order to the orders table without reflection.
+var OrderMapper orderMapper
+
+// orderMapper is the type of OrderMapper.
+type orderMapper struct{}
+
+// orderMapperStatements are the precomputed statements for Order, keyed by dialect.
+var orderMapperStatements = map[string]morph.Statements{
+	"mysql": {
+		Insert: "INSERT INTO orders (line_item, order_id, status, timestamp, total) VALUES (?, ?, ?, ?, ?);",
+		Update: "UPDATE orders AS O SET O.status = ?, O.timestamp = ?, O.total = ? WHERE 1=1 AND O.line_item = ? AND O.order_id = ?;",
+		Delete: "DELETE FROM orders WHERE 1=1 AND line_item = ? AND order_id = ?;",
+		Select: "SELECT O.line_item, O.order_id, O.status, O.timestamp, O.total FROM orders AS O WHERE 1=1 AND O.line_item = ? AND O.order_id = ?;",
+	},
+	"postgres": {
+		Insert: "INSERT INTO orders (line_item, order_id, status, timestamp, total) VALUES ($1, $2, $3, $4, $5);",
+		Update: "UPDATE orders AS O SET status = $1, timestamp = $2, total = $3 WHERE 1=1 AND O.line_item = $4 AND O.order_id = $5;",
+		Delete: "DELETE FROM orders WHERE 1=1 AND line_item = $1 AND order_id = $2;",
+		Select: "SELECT O.line_item, O.order_id, O.status, O.timestamp, O.total FROM orders AS O WHERE 1=1 AND O.line_item = $1 AND O.order_id = $2;",
+	},
+	"sqlite": {
+		Insert: "INSERT INTO orders (line_item, order_id, status, timestamp, total) VALUES (?, ?, ?, ?, ?);",
+		Update: "UPDATE orders AS O SET status = ?, timestamp = ?, total = ? WHERE 1=1 AND O.line_item = ? AND O.order_id = ?;",
+		Delete: "DELETE FROM orders WHERE 1=1 AND line_item = ? AND order_id = ?;",
+		Select: "SELECT O.line_item, O.order_id, O.status, O.timestamp, O.total FROM orders AS O WHERE 1=1 AND O.line_item = ? AND O.order_id = ?;",
+	},
+	"sqlserver": {
+		Insert: "INSERT INTO orders (line_item, order_id, status, timestamp, total) VALUES (@p1, @p2, @p3, @p4, @p5);",
+		Update: "UPDATE orders AS O SET O.status = @p1, O.timestamp = @p2, O.total = @p3 WHERE 1=1 AND O.line_item = @p4 AND O.order_id = @p5;",
+		Delete: "DELETE FROM orders WHERE 1=1 AND line_item = @p1 AND order_id = @p2;",
+		Select: "SELECT O.line_item, O.order_id, O.status, O.timestamp, O.total FROM orders AS O WHERE 1=1 AND O.line_item = @p1 AND O.order_id = @p2;",
+	},
+}
+
+// Table retrieves the metadata mapping Order to the orders table.
+func (orderMapper) Table() morph.Table {
+	var table morph.Table
+	table.SetTypeName("example.Order")
+	table.SetName("orders")
+	table.SetAlias("O")
+	for _, c := range []struct {
+		name, field, fieldType string
+		strategy               morph.FieldStrategy
+		primaryKey             bool
+	}{
+		{"line_item", "LineItem", "int", morph.FieldStrategyStructField, true},
+		{"order_id", "OrderID", "int64", morph.FieldStrategyStructField, true},
+		{"status", "Status", "example.Status", morph.FieldStrategyStructField, false},
+		{"timestamp", "Timestamp", "time.Time", morph.FieldStrategyStructField, false},
+		{"total", "Total", "float64", morph.FieldStrategyStructField, false},
+	} {
+		var column morph.Column
+		column.SetName(c.name)
+		column.SetField(c.field)
+		column.SetFieldType(c.fieldType)
+		column.SetStrategy(c.strategy)
+		column.SetPrimaryKey(c.primaryKey)
+		if err := table.AddColumn(column); err != nil {
+			panic(err)
+		}
+	}
+	return table
+}
+
+// Statements retrieves the statements for Order in the provided dialect, if they
+// were precomputed.
+func (orderMapper) Statements(dialect string) (morph.Statements, bool) {
+	s, ok := orderMapperStatements[dialect]
+	return s, ok
+}
+
+// Evaluate retrieves the values of the columns of the provided Order in column order,
+// which are the arguments of the INSERT statement.
+func (orderMapper) Evaluate(e *Order) []any {
+	return []any{
+		e.LineItem,
+		e.OrderID,
+		e.Status,
+		e.Timestamp,
+		e.Total,
+	}
+}
+
+// UpdateArgs retrieves the arguments of the UPDATE statement for the provided Order.
+func (m orderMapper) UpdateArgs(e *Order) []any {
+	values := m.Evaluate(e)
+	return []any{values[2], values[3], values[4], values[0], values[1]}
+}
+
+// KeyArgs retrieves the arguments of the DELETE and SELECT statements for the provided Order.
+func (m orderMapper) KeyArgs(e *Order) []any {
+	values := m.Evaluate(e)
+	return []any{values[0], values[1]}
+}
+
+// Scan copies the columns of a row retrieved by the SELECT statement into the provided Order.
+func (orderMapper) Scan(row interface{ Scan(dest ...any) error }, e *Order) error {
+	if err := row.Scan(
+		&e.LineItem,
+		&e.OrderID,
+		&e.Status,
+		&e.Timestamp,
+		&e.Total,
+	); err != nil {
+		return err
+	}
+	return nil
+}
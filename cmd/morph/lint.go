@@ -0,0 +1,178 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/freerware/morph"
+)
+
+// Defines the problems reported by the lint command beyond those reported by validation.
+var (
+	// errReservedName represents a table, alias or column name that is a reserved word in
+	// at least one supported SQL dialect, and so fails in generated queries unless quoted.
+	errReservedName = errors.New("morph: name is a reserved SQL word")
+
+	// errAliasCollision represents a table alias that only differs from the alias of another
+	// table by case, which most databases treat as the same alias.
+	errAliasCollision = errors.New("morph: table alias collides with the alias of another table")
+
+	// errSetterMethod represents a method strategy column whose field names a setter, which
+	// requires an argument and so cannot be evaluated.
+	errSetterMethod = errors.New("morph: method fields must not be setters")
+)
+
+// setterRegExp matches the names of setter methods, such as SetName, but not Settings.
+var setterRegExp = regexp.MustCompile(`^Set($|[^a-z])`)
+
+// reservedWords are the words reserved by the SQL standard or by at least one of the supported
+// dialects that are likely to be chosen as table, alias or column names.
+var reservedWords = map[string]bool{
+	"add": true, "all": true, "alter": true, "analyze": true, "and": true, "any": true,
+	"as": true, "asc": true, "authorization": true, "between": true, "both": true, "by": true,
+	"case": true, "cast": true, "check": true, "collate": true, "column": true, "condition": true,
+	"constraint": true, "create": true, "cross": true, "current": true, "current_date": true,
+	"current_time": true, "current_timestamp": true, "current_user": true, "database": true,
+	"default": true, "delete": true, "desc": true, "describe": true, "distinct": true, "div": true,
+	"do": true, "drop": true, "else": true, "end": true, "except": true, "exists": true,
+	"explain": true, "false": true, "fetch": true, "for": true, "foreign": true, "from": true,
+	"full": true, "grant": true, "group": true, "having": true, "if": true, "in": true,
+	"index": true, "inner": true, "insert": true, "intersect": true, "interval": true, "into": true,
+	"is": true, "join": true, "key": true, "keys": true, "leading": true, "left": true,
+	"like": true, "limit": true, "load": true, "lock": true, "match": true, "mod": true,
+	"natural": true, "not": true, "null": true, "of": true, "offset": true, "on": true,
+	"option": true, "or": true, "order": true, "outer": true, "primary": true, "procedure": true,
+	"range": true, "rank": true, "read": true, "references": true, "release": true,
+	"rename": true, "repeat": true, "replace": true, "return": true, "right": true,
+	"rollback": true, "row": true, "rows": true, "schema": true, "select": true,
+	"session_user": true, "set": true, "some": true, "table": true, "then": true, "to": true,
+	"trailing": true, "trigger": true, "true": true, "union": true, "unique": true,
+	"update": true, "usage": true, "user": true, "using": true, "values": true, "when": true,
+	"where": true, "window": true, "with": true,
+}
+
+// runLint runs the lint command, which reports the problems with a configuration that
+// would cause it to be rejected, or to produce queries or evaluations that fail.
+func runLint(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("lint", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: morph lint [flags] <file or directory>...")
+		fmt.Fprintln(fs.Output())
+		fmt.Fprintln(fs.Output(), "Reports invalid tables and columns, reserved words, colliding aliases and setter methods.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+
+	var configs pathList
+	fs.Var(&configs, "config", "comma separated configuration files or directories, which may be repeated")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	configs = append(configs, fs.Args()...)
+	if len(configs) == 0 {
+		fs.Usage()
+		return errors.New("morph: at least one configuration is required")
+	}
+
+	var problems morph.ValidationErrors
+	c, err := loadConfiguration(configs)
+	if err != nil && !errors.As(err, &problems) {
+		return err
+	}
+	if err == nil {
+		problems = lint(c)
+	}
+
+	for _, problem := range problems {
+		fmt.Fprintln(stdout, problem)
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("morph: %d problem(s) found", len(problems))
+	}
+	return nil
+}
+
+// lint retrieves the problems with the provided configuration in document order, which
+// include the problems reported by validation.
+func lint(c morph.Configuration) morph.ValidationErrors {
+	var problems morph.ValidationErrors
+	if err := c.Validate(); err != nil {
+		errors.As(err, &problems)
+	}
+
+	aliases := make(map[string]string)
+	for i, t := range c.Tables {
+		table := strings.TrimSpace(t.Name)
+		if table == "" {
+			table = strings.TrimSpace(t.TypeName)
+		}
+		report := func(column string, position morph.Position, err error) {
+			problems = append(problems, &morph.ValidationError{
+				Table:    table,
+				Column:   column,
+				Position: position,
+				Err:      err,
+			})
+		}
+
+		position := c.Position(fmt.Sprintf("tables[%d]", i))
+		if isReserved(t.Name) {
+			report("", position, fmt.Errorf("%w: table name %q", errReservedName, t.Name))
+		}
+
+		if alias := strings.TrimSpace(t.Alias); alias != "" {
+			if isReserved(alias) {
+				report("", position, fmt.Errorf("%w: alias %q", errReservedName, alias))
+			}
+
+			// exact duplicates are already reported by validation.
+			key := strings.ToLower(alias)
+			if other, ok := aliases[key]; ok && other != alias {
+				report("", position, fmt.Errorf("%w: %q and %q", errAliasCollision, other, alias))
+			} else if !ok {
+				aliases[key] = alias
+			}
+		}
+
+		for j, col := range t.Columns {
+			column := strings.TrimSpace(col.Name)
+			position := c.Position(fmt.Sprintf("tables[%d].columns[%d]", i, j))
+			if isReserved(column) {
+				report(column, position, fmt.Errorf("%w: column name %q", errReservedName, column))
+			}
+
+			if field := strings.TrimSpace(col.Field); col.FieldStrategy == morph.FieldStrategyMethod && setterRegExp.MatchString(field) {
+				report(column, position, fmt.Errorf("%w: %q", errSetterMethod, field))
+			}
+		}
+	}
+
+	// files are ordered as provided, rather than by name.
+	files := make(map[string]int)
+	for i := range c.Tables {
+		if file := c.Position(fmt.Sprintf("tables[%d]", i)).File; file != "" {
+			if _, ok := files[file]; !ok {
+				files[file] = len(files)
+			}
+		}
+	}
+	sort.SliceStable(problems, func(i, j int) bool {
+		pi, pj := problems[i].Position, problems[j].Position
+		if pi.File != pj.File {
+			return files[pi.File] < files[pj.File]
+		}
+		return pi.Line < pj.Line || (pi.Line == pj.Line && pi.Column < pj.Column)
+	})
+	return problems
+}
+
+// isReserved determines if the provided name is a reserved word, regardless of case.
+func isReserved(name string) bool {
+	return reservedWords[strings.ToLower(strings.TrimSpace(name))]
+}
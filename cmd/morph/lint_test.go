@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LintTestSuite struct {
+	suite.Suite
+
+	stdout *bytes.Buffer
+	stderr *bytes.Buffer
+}
+
+func TestLintTestSuite(t *testing.T) {
+	suite.Run(t, new(LintTestSuite))
+}
+
+func (s *LintTestSuite) SetupTest() {
+	s.stdout, s.stderr = &bytes.Buffer{}, &bytes.Buffer{}
+}
+
+func (s *LintTestSuite) TestRunLint() {
+	// action.
+	err := run([]string{"lint", "testdata/users.yaml", "testdata/orders.json"}, s.stdout, s.stderr)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Empty(s.stdout.String())
+}
+
+func (s *LintTestSuite) TestRunLint_Problems() {
+	// arrange.
+	args := []string{"lint", "-config", "testdata/users.yaml", "testdata/problems.yaml"}
+
+	// action.
+	err := run(args, s.stdout, s.stderr)
+
+	// assert.
+	s.Require().EqualError(err, "morph: 6 problem(s) found")
+	s.Equal(
+		`morph: testdata/problems.yaml:2:5: table "user": name is a reserved SQL word: table name "user"`+"\n"+
+			`morph: testdata/problems.yaml:2:5: table "user": table alias collides with the alias of another table: "U" and "u"`+"\n"+
+			`morph: testdata/problems.yaml:11:9: table "user", column "desc": name is a reserved SQL word: column name "desc"`+"\n"+
+			`morph: testdata/problems.yaml:15:9: table "user", column "given_name": method fields must not be setters: "SetGivenName"`+"\n"+
+			`morph: testdata/problems.yaml:23:5: table "audits": table must have at least one non-primary key column`+"\n"+
+			`morph: testdata/problems.yaml:23:5: table "audits": name is a reserved SQL word: alias "AS"`+"\n",
+		s.stdout.String(),
+	)
+}
+
+func (s *LintTestSuite) TestRunLint_FileOrder() {
+	// arrange.
+	args := []string{"lint", "testdata/problems.yaml", "testdata/users.yaml"}
+
+	// action.
+	err := run(args, s.stdout, s.stderr)
+
+	// assert.
+	s.Require().Error(err)
+	s.True(bytes.HasSuffix(s.stdout.Bytes(), []byte(`morph: testdata/users.yaml:2:5: table "users": table alias collides with the alias of another table: "u" and "U"`+"\n")))
+}
+
+func (s *LintTestSuite) TestRunLint_MergeProblems() {
+	// action.
+	err := run([]string{"lint", "testdata/users.yaml", "testdata/users.yaml"}, s.stdout, s.stderr)
+
+	// assert.
+	s.Require().EqualError(err, "morph: 2 problem(s) found")
+	s.Equal(
+		`morph: testdata/users.yaml:2:5: table "users": table type name must be unique across configurations`+"\n"+
+			`morph: testdata/users.yaml:2:5: table "users": table name must be unique across configurations`+"\n",
+		s.stdout.String(),
+	)
+}
+
+func (s *LintTestSuite) TestRunLint_MissingConfiguration() {
+	// action.
+	err := run([]string{"lint"}, s.stdout, s.stderr)
+
+	// assert.
+	s.Require().EqualError(err, "morph: at least one configuration is required")
+}
+
+func (s *LintTestSuite) TestSetterRegExp() {
+	s.True(setterRegExp.MatchString("SetName"))
+	s.True(setterRegExp.MatchString("Set"))
+	s.True(setterRegExp.MatchString("Set_name"))
+	s.False(setterRegExp.MatchString("Settings"))
+	s.False(setterRegExp.MatchString("Setup"))
+	s.False(setterRegExp.MatchString("Reset"))
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/freerware/morph"
+)
+
+// pathList is a flag value collecting the paths provided by repeated or comma separated flags.
+type pathList []string
+
+// String retrieves the collected paths as a comma separated list.
+func (p *pathList) String() string {
+	return strings.Join(*p, ",")
+}
+
+// Set adds the provided comma separated paths to the collected paths.
+func (p *pathList) Set(value string) error {
+	*p = append(*p, split(value)...)
+	return nil
+}
+
+// loadConfiguration loads and merges the configurations from the provided files and
+// directories, in the order provided. See morph.Merge for how they are combined.
+func loadConfiguration(paths []string) (morph.Configuration, error) {
+	configs := make([]morph.Configuration, 0, len(paths))
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return morph.Configuration{}, err
+		}
+
+		var c morph.Configuration
+		if info.IsDir() {
+			c, err = morph.LoadDir(path)
+		} else {
+			c, err = morph.Load(path)
+		}
+		if err != nil {
+			return morph.Configuration{}, fmt.Errorf("morph: unable to load %q: %w", path, err)
+		}
+		configs = append(configs, c)
+	}
+	return morph.Merge(configs...)
+}
@@ -0,0 +1,79 @@
+// Command morph generates and inspects morph mapping configurations without
+// running the code that defines the mapped entities.
+//
+// Usage:
+//
+//	morph <command> [flags] [arguments]
+//
+// The commands are:
+//
+//	config    generate a configuration from the struct types of a Go package
+//	generate  generate reflection-free mappers for the struct types of a Go package
+//	lint      report problems with a configuration
+//	queries   print the statements generated for a configuration
+//	structs   generate the struct types mapped by a configuration
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+// command represents a subcommand of the morph command.
+type command struct {
+	summary string
+	run     func(args []string, stdout, stderr io.Writer) error
+}
+
+// commands is the collection of subcommands by name.
+var commands = map[string]command{
+	"config":   {summary: "generate a configuration from the struct types of a Go package", run: runConfig},
+	"generate": {summary: "generate reflection-free mappers for the struct types of a Go package", run: runGenerate},
+	"lint":     {summary: "report problems with a configuration", run: runLint},
+	"queries":  {summary: "print the statements generated for a configuration", run: runQueries},
+	"structs":  {summary: "generate the struct types mapped by a configuration", run: runStructs},
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		os.Exit(2)
+	}
+}
+
+// run runs the subcommand named by the first of the provided arguments.
+func run(args []string, stdout, stderr io.Writer) error {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		usage(stderr)
+		return flag.ErrHelp
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		usage(stderr)
+		return fmt.Errorf("morph: unknown command %q", args[0])
+	}
+	return cmd.run(args[1:], stdout, stderr)
+}
+
+// usage writes the usage of the morph command to the provided writer.
+func usage(w io.Writer) {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(w, "Usage: morph <command> [flags] [arguments]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-10s%s\n", name, commands[name].summary)
+	}
+}
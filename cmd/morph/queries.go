@@ -0,0 +1,89 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/freerware/morph"
+)
+
+// runQueries runs the queries command, which prints the statements generated for every
+// table of a configuration so that they can be reviewed alongside changes to it.
+func runQueries(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("queries", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: morph queries [flags] -config <file or directory>")
+		fmt.Fprintln(fs.Output())
+		fmt.Fprintln(fs.Output(), "Prints the INSERT, UPDATE, DELETE and SELECT statements for every table of a configuration.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+
+	var configs pathList
+	fs.Var(&configs, "config", "comma separated configuration files or directories, which may be repeated (required)")
+	var (
+		dialect = fs.String("dialect", "", "the SQL dialect of the statements: "+strings.Join(dialects(), ", ")+" (default ? placeholders)")
+		named   = fs.Bool("named", false, "use named parameters instead of placeholders")
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	configs = append(configs, fs.Args()...)
+	if len(configs) == 0 {
+		fs.Usage()
+		return errors.New("morph: at least one configuration is required")
+	}
+
+	var options []morph.QueryOption
+	if *dialect != "" {
+		opts, ok := morph.Dialects[*dialect]
+		if !ok {
+			return fmt.Errorf("morph: unsupported dialect %q", *dialect)
+		}
+		options = append(options, opts...)
+	}
+	if *named {
+		options = append(options, morph.WithNamedParameters())
+	}
+
+	c, err := loadConfiguration(configs)
+	if err != nil {
+		return err
+	}
+	tables, err := c.AsMetadataStrict()
+	if err != nil {
+		return err
+	}
+
+	for i, t := range tables {
+		statements, err := t.Statements(options...)
+		if err != nil {
+			return fmt.Errorf("morph: table %q: %w", t.Name(), err)
+		}
+
+		if i > 0 {
+			fmt.Fprintln(stdout)
+		}
+		fmt.Fprintf(stdout, "-- %s (%s)\n", t.Name(), t.TypeName())
+		fmt.Fprintln(stdout, statements.Insert)
+		fmt.Fprintln(stdout, statements.Update)
+		fmt.Fprintln(stdout, statements.Delete)
+		fmt.Fprintln(stdout, statements.Select)
+	}
+	return nil
+}
+
+// dialects retrieves the names of the supported SQL dialects in lexical order.
+func dialects() []string {
+	names := make([]string, 0, len(morph.Dialects))
+	for name := range morph.Dialects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type QueriesTestSuite struct {
+	suite.Suite
+
+	stdout *bytes.Buffer
+	stderr *bytes.Buffer
+}
+
+func TestQueriesTestSuite(t *testing.T) {
+	suite.Run(t, new(QueriesTestSuite))
+}
+
+func (s *QueriesTestSuite) SetupTest() {
+	s.stdout, s.stderr = &bytes.Buffer{}, &bytes.Buffer{}
+}
+
+func (s *QueriesTestSuite) SetupSubTest() {
+	s.SetupTest()
+}
+
+func (s *QueriesTestSuite) TestRunQueries() {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name: "Default",
+			args: []string{"queries", "-config", "testdata/users.yaml"},
+			expected: "-- users (example.User)\n" +
+				"INSERT INTO users (given_name, id, username) VALUES (?, ?, ?);\n" +
+				"UPDATE users AS U SET U.given_name = ?, U.username = ? WHERE 1=1 AND U.id = ?;\n" +
+				"DELETE FROM users WHERE 1=1 AND id = ?;\n" +
+				"SELECT U.given_name, U.id, U.username FROM users AS U WHERE 1=1 AND U.id = ?;\n",
+		},
+		{
+			name: "Dialect",
+			args: []string{"queries", "-config", "testdata/orders.json", "-dialect", "postgres"},
+			expected: "-- orders (example.Order)\n" +
+				"INSERT INTO orders (line_item, order_id, total) VALUES ($1, $2, $3);\n" +
+				"UPDATE orders AS O SET total = $1 WHERE 1=1 AND O.line_item = $2 AND O.order_id = $3;\n" +
+				"DELETE FROM orders WHERE 1=1 AND line_item = $1 AND order_id = $2;\n" +
+				"SELECT O.line_item, O.order_id, O.total FROM orders AS O WHERE 1=1 AND O.line_item = $1 AND O.order_id = $2;\n",
+		},
+		{
+			name: "Named",
+			args: []string{"queries", "-named", "testdata/orders.json"},
+			expected: "-- orders (example.Order)\n" +
+				"INSERT INTO orders (line_item, order_id, total) VALUES (:line_item, :order_id, :total);\n" +
+				"UPDATE orders AS O SET O.total = :total WHERE 1=1 AND O.line_item = :line_item AND O.order_id = :order_id;\n" +
+				"DELETE FROM orders WHERE 1=1 AND line_item = :line_item AND order_id = :order_id;\n" +
+				"SELECT O.line_item, O.order_id, O.total FROM orders AS O WHERE 1=1 AND O.line_item = :line_item AND O.order_id = :order_id;\n",
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// action.
+			err := run(test.args, s.stdout, s.stderr)
+
+			// assert.
+			s.Require().NoError(err)
+			s.Equal(test.expected, s.stdout.String())
+		})
+	}
+}
+
+func (s *QueriesTestSuite) TestRunQueries_MultipleConfigurations() {
+	// arrange.
+	args := []string{"queries", "-config", "testdata/users.yaml,testdata/orders.json", "-dialect", "sqlserver"}
+
+	// action.
+	err := run(args, s.stdout, s.stderr)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Contains(s.stdout.String(), "-- users (example.User)\n")
+	s.Contains(s.stdout.String(), "\n\n-- orders (example.Order)\n")
+	s.Contains(s.stdout.String(), "DELETE FROM orders WHERE 1=1 AND line_item = @p1 AND order_id = @p2;\n")
+}
+
+func (s *QueriesTestSuite) TestRunQueries_Errors() {
+	tests := []struct {
+		name string
+		args []string
+		err  string
+	}{
+		{name: "MissingConfiguration", args: []string{"queries"}, err: "morph: at least one configuration is required"},
+		{name: "UnsupportedDialect", args: []string{"queries", "-dialect", "oracle", "testdata/users.yaml"}, err: `morph: unsupported dialect "oracle"`},
+		{name: "MissingFile", args: []string{"queries", "testdata/missing.yaml"}, err: "stat testdata/missing.yaml: no such file or directory"},
+		{name: "InvalidConfiguration", args: []string{"queries", "testdata/problems.yaml"}, err: `morph: testdata/problems.yaml:23:5: table "audits": table must have at least one non-primary key column`},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// action.
+			err := run(test.args, s.stdout, s.stderr)
+
+			// assert.
+			s.Require().EqualError(err, test.err)
+			s.Empty(s.stdout.String())
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"fmt"
+	"go/types"
+	"reflect"
+
+	"github.com/freerware/morph"
+	"golang.org/x/tools/go/packages"
+)
+
+// tablesFor loads the package matching the provided pattern, relative to the provided
+// directory, and constructs the tables for its struct types with the provided names.
+func tablesFor(dir, pattern string, names []string, options ...morph.ReflectOption) ([]morph.Table, error) {
+	pkg, err := loadPackage(dir, pattern)
+	if err != nil {
+		return nil, err
+	}
+	return tablesIn(pkg, names, options...)
+}
+
+// loadPackage loads the type information for the package matching the provided pattern,
+// relative to the provided directory.
+func loadPackage(dir, pattern string) (*types.Package, error) {
+	// the package and its dependencies are type checked from source, rather than from
+	// export data, so that the command works regardless of the installed Go version.
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes | packages.NeedSyntax |
+			packages.NeedImports | packages.NeedDeps | packages.NeedTypesSizes,
+		Dir: dir,
+	}
+	pkgs, err := packages.Load(cfg, pattern)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("morph: expected one package matching %q, found %d", pattern, len(pkgs))
+	}
+	pkg := pkgs[0]
+	if len(pkg.Errors) > 0 {
+		return nil, fmt.Errorf("morph: unable to load package %q: %w", pattern, pkg.Errors[0])
+	}
+	return pkg.Types, nil
+}
+
+// tablesIn constructs the tables for the struct types with the provided names within the
+// provided package. The tables are constructed from the type information alone, using the
+// same naming rules as morph.Reflect.
+func tablesIn(pkg *types.Package, names []string, options ...morph.ReflectOption) ([]morph.Table, error) {
+	c, err := morph.NewReflectConfiguration(options...)
+	if err != nil {
+		return nil, err
+	}
+	tables := make([]morph.Table, 0, len(names))
+	for _, name := range names {
+		obj, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+		if !ok {
+			return nil, fmt.Errorf("morph: no type %q in package %q", name, pkg.Path())
+		}
+		named, ok := obj.Type().(*types.Named)
+		if !ok || !isStruct(named) {
+			return nil, fmt.Errorf("morph: type %q in package %q is not a struct", name, pkg.Path())
+		}
+
+		table, err := tableFor(named, c)
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+	return tables, nil
+}
+
+// tableFor constructs the table for the provided named struct type.
+func tableFor(named *types.Named, c morph.ReflectConfiguration) (morph.Table, error) {
+	obj := named.Obj()
+	tableName := c.TableNameFor(obj.Name())
+
+	var table morph.Table
+	table.SetTypeName(obj.Pkg().Name() + "." + obj.Name())
+	table.SetName(tableName)
+	table.SetAlias(c.TableAliasFor(tableName))
+
+	columns := []morph.Column{}
+	st := named.Underlying().(*types.Struct)
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+		if !field.Exported() || isUnsupportedStruct(field.Type()) {
+			continue
+		}
+
+		columnName, ok := c.FieldColumnName(field.Name(), reflect.StructTag(st.Tag(i)))
+		if !ok {
+			continue
+		}
+
+		var column morph.Column
+		column.SetField(field.Name())
+		column.SetName(columnName)
+		column.SetPrimaryKey(c.IsPrimaryKeyColumn(columnName))
+		column.SetFieldType(typeString(field.Type()))
+		column.SetStrategy(morph.FieldStrategyStructField)
+		columns = append(columns, column)
+	}
+
+	methods := types.NewMethodSet(types.NewPointer(named))
+	for i := 0; i < methods.Len(); i++ {
+		method := methods.At(i).Obj()
+		if !method.Exported() {
+			continue
+		}
+
+		results := method.Type().(*types.Signature).Results()
+		if results.Len() == 0 || isUnsupportedStruct(results.At(0).Type()) {
+			continue
+		}
+
+		columnName, ok := c.MethodColumnName(method.Name())
+		if !ok {
+			continue
+		}
+
+		var column morph.Column
+		column.SetField(method.Name())
+		column.SetName(columnName)
+		column.SetPrimaryKey(c.IsPrimaryKeyColumn(columnName))
+		column.SetFieldType(typeString(results.At(0).Type()))
+		column.SetStrategy(morph.FieldStrategyMethod)
+		columns = append(columns, column)
+	}
+
+	if err := table.AddColumns(columns...); err != nil {
+		return morph.Table{}, err
+	}
+	return table, nil
+}
+
+// isStruct determines if the provided type is a struct.
+func isStruct(t types.Type) bool {
+	_, ok := t.Underlying().(*types.Struct)
+	return ok
+}
+
+// isUnsupportedStruct determines if the provided type, or the type it points to, is
+// a struct other than time.Time, which reflection does not map to a column.
+func isUnsupportedStruct(t types.Type) bool {
+	if p, ok := t.Underlying().(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	if named, ok := types.Unalias(t).(*types.Named); ok {
+		obj := named.Obj()
+		if obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == "Time" {
+			return false
+		}
+	}
+	return isStruct(t)
+}
+
+// typeString formats the provided type in the same way as reflect.Type.String, so that
+// field types match those found by reflection.
+func typeString(t types.Type) string {
+	switch t := types.Unalias(t).(type) {
+	case *types.Basic:
+		if t.Kind() == types.UnsafePointer {
+			return "unsafe.Pointer"
+		}
+		return types.Typ[t.Kind()].Name()
+	case *types.Pointer:
+		return "*" + typeString(t.Elem())
+	case *types.Slice:
+		return "[]" + typeString(t.Elem())
+	case *types.Array:
+		return fmt.Sprintf("[%d]%s", t.Len(), typeString(t.Elem()))
+	case *types.Map:
+		return "map[" + typeString(t.Key()) + "]" + typeString(t.Elem())
+	case *types.Interface:
+		if t.Empty() {
+			return "interface {}"
+		}
+	case *types.Named:
+		if pkg := t.Obj().Pkg(); pkg != nil {
+			return pkg.Name() + "." + t.Obj().Name()
+		}
+		return t.Obj().Name()
+	}
+	return types.TypeString(t, func(p *types.Package) string { return p.Name() })
+}
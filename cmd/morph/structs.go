@@ -0,0 +1,189 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/freerware/morph"
+	"golang.org/x/tools/imports"
+)
+
+// runStructs runs the structs command, which generates the struct types mapped by a
+// configuration, as a starting point for the entities of a new service.
+func runStructs(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("structs", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: morph structs [flags] -config <file or directory>")
+		fmt.Fprintln(fs.Output())
+		fmt.Fprintln(fs.Output(), "Generates the struct types mapped by a configuration, with a field for each struct_field")
+		fmt.Fprintln(fs.Output(), "column and a getter and setter for each method column.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+
+	var configs pathList
+	fs.Var(&configs, "config", "comma separated configuration files or directories, which may be repeated (required)")
+	var (
+		output  = fs.String("o", "", "the file to write the struct types to (default stdout)")
+		pkgName = fs.String("package", "", "the name of the package of the struct types (default the package qualifying the type names)")
+		tag     = fs.String("tag", "morph", "the struct tag naming the column of each field")
+		tags    = fs.String("tags", "", "comma separated additional struct tags naming the column of each field, such as json,db")
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	configs = append(configs, fs.Args()...)
+	if len(configs) == 0 {
+		fs.Usage()
+		return errors.New("morph: at least one configuration is required")
+	}
+
+	c, err := loadConfiguration(configs)
+	if err != nil {
+		return err
+	}
+	if _, err := c.AsMetadataStrict(); err != nil {
+		return err
+	}
+
+	var keys []string
+	if strings.TrimSpace(*tag) != "" {
+		keys = append(keys, strings.TrimSpace(*tag))
+	}
+	keys = append(keys, split(*tags)...)
+
+	src, err := generateStructs(c, *pkgName, keys)
+	if err != nil {
+		return err
+	}
+	if *output == "" {
+		_, err = stdout.Write(src)
+		return err
+	}
+	return os.WriteFile(*output, src, 0o644)
+}
+
+// entity represents a generated struct type.
+type entity struct {
+	Type    string
+	Table   string
+	Fields  []field
+	Getters []field
+}
+
+// field represents a field, or the getter and setter of an unexported field, of a generated struct type.
+type field struct {
+	Name   string
+	Type   string
+	Tag    string
+	Column string
+
+	// Var is the name of the unexported field retrieved by a getter and assigned by a setter.
+	Var string
+}
+
+// generateStructs generates the formatted source of the struct types mapped by the tables
+// of the provided configuration, in the order they are configured, within the package with
+// the provided name, or otherwise the package qualifying the type names. The fields are tagged
+// with the column names using the provided tag keys.
+func generateStructs(c morph.Configuration, pkgName string, keys []string) ([]byte, error) {
+	inferred := pkgName == ""
+	declared := make(map[string]bool)
+	entities := make([]entity, 0, len(c.Tables))
+	for i, t := range c.Tables {
+		qualifier, typeName := splitTypeName(t.TypeName)
+		if inferred && i == 0 {
+			pkgName = qualifier
+		}
+		if inferred && qualifier != pkgName {
+			return nil, fmt.Errorf("morph: type %q does not belong to package %q", t.TypeName, pkgName)
+		}
+		if declared[typeName] {
+			return nil, fmt.Errorf("morph: type %q is declared more than once", typeName)
+		}
+		declared[typeName] = true
+
+		e := entity{Type: typeName, Table: t.Name}
+		vars := make(map[string]bool)
+		for _, col := range t.Columns {
+			fieldType := strings.TrimSpace(col.FieldType)
+			if fieldType == "" {
+				fieldType = "any"
+			}
+
+			if col.FieldStrategy == morph.FieldStrategyMethod {
+				e.Getters = append(e.Getters, field{Name: col.Field, Type: fieldType, Column: col.Name, Var: localName(col.Field, vars)})
+				continue
+			}
+
+			tag := make([]string, 0, len(keys))
+			for _, key := range keys {
+				tag = append(tag, key+":"+strconv.Quote(col.Name))
+			}
+			e.Fields = append(e.Fields, field{Name: col.Field, Type: fieldType, Tag: strings.Join(tag, " "), Column: col.Name})
+		}
+		entities = append(entities, e)
+	}
+	if pkgName == "" {
+		return nil, errors.New("morph: a package name is required when the type names are not qualified")
+	}
+
+	data := struct {
+		Package  string
+		Entities []entity
+	}{pkgName, entities}
+
+	var buf bytes.Buffer
+	if err := structsTmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	src, err := imports.Process(pkgName+".go", buf.Bytes(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("morph: unable to format generated source: %w", err)
+	}
+	return src, nil
+}
+
+// splitTypeName splits the provided type name into its package qualifier, if any, and
+// the name of the type.
+func splitTypeName(typeName string) (string, string) {
+	typeName = strings.TrimPrefix(strings.TrimSpace(typeName), "*")
+	if i := strings.LastIndex(typeName, "."); i >= 0 {
+		return typeName[:i], typeName[i+1:]
+	}
+	return "", typeName
+}
+
+// structsTmpl is the parsed template used to generate the struct types.
+var structsTmpl = template.Must(template.New("structs").Parse(`package {{.Package}}
+{{range .Entities}}{{$type := .Type}}
+// {{.Type}} is mapped to the {{.Table}} table.
+type {{.Type}} struct {
+{{- range .Fields}}
+	{{.Name}} {{.Type}}{{if .Tag}} ` + "`{{.Tag}}`" + `{{end}}
+{{- end}}
+{{- if and .Fields .Getters}}
+{{end}}
+{{- range .Getters}}
+	{{.Var}} {{.Type}}
+{{- end}}
+}
+{{range .Getters}}
+// {{.Name}} retrieves the value of the {{.Column}} column.
+func (e *{{$type}}) {{.Name}}() {{.Type}} {
+	return e.{{.Var}}
+}
+
+// Set{{.Name}} assigns the value of the {{.Column}} column.
+func (e *{{$type}}) Set{{.Name}}(value {{.Type}}) {
+	e.{{.Var}} = value
+}
+{{end}}{{end}}`))
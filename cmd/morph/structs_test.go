@@ -0,0 +1,181 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/freerware/morph"
+	"github.com/stretchr/testify/suite"
+)
+
+type StructsTestSuite struct {
+	suite.Suite
+
+	stdout *bytes.Buffer
+	stderr *bytes.Buffer
+}
+
+func TestStructsTestSuite(t *testing.T) {
+	suite.Run(t, new(StructsTestSuite))
+}
+
+func (s *StructsTestSuite) SetupTest() {
+	s.stdout, s.stderr = &bytes.Buffer{}, &bytes.Buffer{}
+}
+
+func (s *StructsTestSuite) SetupSubTest() {
+	s.SetupTest()
+}
+
+func (s *StructsTestSuite) TestRunStructs() {
+	// arrange.
+	args := []string{"structs", "-tags", "json,db", "-config", "testdata/users.yaml", "testdata/orders.json"}
+
+	// action.
+	err := run(args, s.stdout, s.stderr)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal(`package example
+
+// User is mapped to the users table.
+type User struct {
+	ID       int64  `+"`"+`morph:"id" json:"id" db:"id"`+"`"+`
+	Username string `+"`"+`morph:"username" json:"username" db:"username"`+"`"+`
+
+	givenName string
+}
+
+// GivenName retrieves the value of the given_name column.
+func (e *User) GivenName() string {
+	return e.givenName
+}
+
+// SetGivenName assigns the value of the given_name column.
+func (e *User) SetGivenName(value string) {
+	e.givenName = value
+}
+
+// Order is mapped to the orders table.
+type Order struct {
+	OrderID  int64   `+"`"+`morph:"order_id" json:"order_id" db:"order_id"`+"`"+`
+	LineItem int     `+"`"+`morph:"line_item" json:"line_item" db:"line_item"`+"`"+`
+	Total    float64 `+"`"+`morph:"total" json:"total" db:"total"`+"`"+`
+}
+`, s.stdout.String())
+}
+
+func (s *StructsTestSuite) TestRunStructs_Output() {
+	// arrange.
+	path := filepath.Join(s.T().TempDir(), "entities.go")
+	args := []string{"structs", "-package", "models", "-tag", "db", "-o", path, "testdata/orders.json"}
+
+	// action.
+	err := run(args, s.stdout, s.stderr)
+
+	// assert.
+	s.Require().NoError(err)
+	generated, err := os.ReadFile(path)
+	s.Require().NoError(err)
+	s.Contains(string(generated), "package models\n")
+	s.Contains(string(generated), `OrderID  int64   `+"`"+`db:"order_id"`+"`")
+	s.Empty(s.stdout.String())
+}
+
+func (s *StructsTestSuite) TestRunStructs_Errors() {
+	tests := []struct {
+		name string
+		args []string
+		err  string
+	}{
+		{name: "MissingConfiguration", args: []string{"structs"}, err: "morph: at least one configuration is required"},
+		{name: "MultiplePackages", args: []string{"structs", "testdata/example.yaml"}, err: `morph: type "other.Ignored" does not belong to package "example"`},
+		{name: "InvalidConfiguration", args: []string{"structs", "testdata/problems.yaml"}, err: `morph: testdata/problems.yaml:23:5: table "audits": table must have at least one non-primary key column`},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// action.
+			err := run(test.args, s.stdout, s.stderr)
+
+			// assert.
+			s.Require().EqualError(err, test.err)
+		})
+	}
+}
+
+func (s *StructsTestSuite) TestGenerateStructs() {
+	// arrange.
+	c := morph.Configuration{Tables: []morph.TableConfiguration{{
+		TypeName: "Event",
+		Name:     "events",
+		Alias:    "E",
+		Columns: []morph.ColumnConfiguration{
+			{Name: "id", Field: "ID", FieldType: "int64", FieldStrategy: morph.FieldStrategyStructField, PrimaryKey: true},
+			{Name: "occurred_at", Field: "OccurredAt", FieldType: "*time.Time", FieldStrategy: morph.FieldStrategyStructField},
+			{Name: "payload", Field: "Payload", FieldStrategy: morph.FieldStrategyStructField},
+			{Name: "type", Field: "Type", FieldType: "string", FieldStrategy: morph.FieldStrategyMethod},
+		},
+	}}}
+
+	// action.
+	src, err := generateStructs(c, "models", nil)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal(`package models
+
+import "time"
+
+// Event is mapped to the events table.
+type Event struct {
+	ID         int64
+	OccurredAt *time.Time
+	Payload    any
+
+	typeValue string
+}
+
+// Type retrieves the value of the type column.
+func (e *Event) Type() string {
+	return e.typeValue
+}
+
+// SetType assigns the value of the type column.
+func (e *Event) SetType(value string) {
+	e.typeValue = value
+}
+`, string(src))
+}
+
+func (s *StructsTestSuite) TestGenerateStructs_Errors() {
+	table := func(typeName string) morph.TableConfiguration {
+		return morph.TableConfiguration{
+			TypeName: typeName,
+			Name:     "users",
+			Columns:  []morph.ColumnConfiguration{{Name: "id", Field: "ID", FieldType: "int"}},
+		}
+	}
+	tests := []struct {
+		name    string
+		tables  []morph.TableConfiguration
+		pkgName string
+		err     string
+	}{
+		{name: "MissingPackage", tables: []morph.TableConfiguration{table("User")}, err: "morph: a package name is required when the type names are not qualified"},
+		{name: "PartiallyQualified", tables: []morph.TableConfiguration{table("User"), table("models.Order")}, err: `morph: type "models.Order" does not belong to package ""`},
+		{name: "DuplicateType", tables: []morph.TableConfiguration{table("models.User"), table("entities.User")}, pkgName: "models", err: `morph: type "User" is declared more than once`},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// action.
+			_, err := generateStructs(morph.Configuration{Tables: test.tables}, test.pkgName, []string{"morph"})
+
+			// assert.
+			s.Require().EqualError(err, test.err)
+		})
+	}
+}
@@ -1,50 +1,483 @@
 package morph
 
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"slices"
+	"strings"
+)
+
+// Defines the various errors that can occur when validating configurations.
+var (
+	// ErrMissingColumnName represents an error encountered when a column does not have a name configured.
+	ErrMissingColumnName = errors.New("morph: column must have a name")
+
+	// ErrMissingColumnField represents an error encountered when a column does not have a field configured.
+	ErrMissingColumnField = errors.New("morph: column must have a field")
+
+	// ErrDuplicateColumnName represents an error encountered when a table has multiple columns with the same name.
+	ErrDuplicateColumnName = errors.New("morph: column name must be unique within the table")
+
+	// ErrDuplicateColumnField represents an error encountered when a table has multiple columns with the same field.
+	ErrDuplicateColumnField = errors.New("morph: column field must be unique within the table")
+
+	// ErrInvalidFieldStrategy represents an error encountered when a column has an unknown field strategy.
+	ErrInvalidFieldStrategy = errors.New(`morph: column field strategy must be "struct_field" or "method"`)
+
+	// ErrInvalidFieldType represents an error encountered when a column has a field type that is not a Go type.
+	ErrInvalidFieldType = errors.New("morph: column field type must be a valid Go type")
+
+	// ErrDuplicateTypeName represents an error encountered when merged configurations have
+	// multiple tables with the same type name.
+	ErrDuplicateTypeName = errors.New("morph: table type name must be unique across configurations")
+
+	// ErrDuplicateTableName represents an error encountered when merged configurations have
+	// multiple tables with the same name.
+	ErrDuplicateTableName = errors.New("morph: table name must be unique across configurations")
+
+	// ErrUnmatchedOverride represents an error encountered when an override does not match
+	// the type name of any table.
+	ErrUnmatchedOverride = errors.New("morph: override must match the type name of a table")
+
+	// ErrDuplicateTableAlias represents an error encountered when multiple tables have the same alias.
+	ErrDuplicateTableAlias = errors.New("morph: table alias must be unique across tables")
+)
+
+// ValidationError represents a single problem found when validating a configuration.
+type ValidationError struct {
+	Table    string
+	Column   string
+	Position Position
+	Err      error
+}
+
+// Error retrieves the message for the validation error.
+func (e *ValidationError) Error() string {
+	var b strings.Builder
+	b.WriteString("morph: ")
+	if e.Position.File != "" || e.Position.IsValid() {
+		b.WriteString(e.Position.String() + ": ")
+	}
+	if e.Table != "" {
+		b.WriteString(fmt.Sprintf("table %q", e.Table))
+		if e.Column != "" {
+			b.WriteString(fmt.Sprintf(", column %q", e.Column))
+		}
+		b.WriteString(": ")
+	}
+	b.WriteString(strings.TrimPrefix(e.Err.Error(), "morph: "))
+	return b.String()
+}
+
+// Unwrap retrieves the underlying error.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationErrors represents all of the problems found when validating a configuration.
+type ValidationErrors []*ValidationError
+
+// Error retrieves the messages for all of the validation errors, one per line.
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+// Unwrap retrieves the underlying validation errors.
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
 // Configuration represents the configuration used to construct
 // the table and column mappings.
 type Configuration struct {
-	Tables []TableConfiguration `json:"tables" yaml:"tables"`
+	Tables    []TableConfiguration `json:"tables" yaml:"tables" toml:"tables" hcl:"table,block"`
+	Overrides []TableOverride      `json:"overrides,omitempty" yaml:"overrides,omitempty" toml:"overrides,omitempty" hcl:"override,block"`
+
+	// file and positions locate the values of configurations produced by the loaders and
+	// Merge, which are therefore compared using their Tables and Overrides.
+	file      string
+	positions positions
+}
+
+// Position retrieves the position of the value at the provided path, such as
+// "tables[0].columns[1]", within the file the configuration was loaded from.
+func (c Configuration) Position(path string) Position {
+	p := c.positions[path]
+	if p.File == "" {
+		p.File = c.file
+	}
+	return p
+}
+
+// Merge combines the provided configurations into a single configuration, in the order
+// provided, and then applies all of their overrides. Every table must have a unique type
+// name and table name across the configurations, and every override must match a table.
+func Merge(configs ...Configuration) (Configuration, error) {
+	var merged Configuration
+	merged.positions = make(positions)
+
+	type override struct {
+		TableOverride
+		position Position
+	}
+	var overrides []override
+
+	for _, c := range configs {
+		offset := len(merged.Tables)
+		merged.Tables = append(merged.Tables, c.Tables...)
+		for path, p := range c.positions {
+			if p.File == "" {
+				p.File = c.file
+			}
+			merged.positions[reindex(path, offset)] = p
+		}
+		for i, o := range c.Overrides {
+			overrides = append(overrides, override{o, c.Position(overridePath(i))})
+		}
+	}
+
+	var errs ValidationErrors
+	for _, o := range overrides {
+		if !o.apply(merged.Tables) {
+			errs = append(errs, &ValidationError{Table: strings.TrimSpace(o.TypeName), Position: o.position, Err: ErrUnmatchedOverride})
+		}
+	}
+
+	typeNames, names := make(map[string]bool), make(map[string]bool)
+	for i, t := range merged.Tables {
+		report := func(err error) {
+			errs = append(errs, &ValidationError{
+				Table:    t.label(i),
+				Position: merged.Position(tablePath(i)),
+				Err:      err,
+			})
+		}
+
+		if typeName := strings.TrimSpace(t.TypeName); typeName != "" {
+			if typeNames[typeName] {
+				report(ErrDuplicateTypeName)
+			}
+			typeNames[typeName] = true
+		}
+
+		if name := strings.TrimSpace(t.Name); name != "" {
+			if names[name] {
+				report(ErrDuplicateTableName)
+			}
+			names[name] = true
+		}
+	}
+
+	if len(errs) > 0 {
+		return Configuration{}, errs
+	}
+	return merged, nil
+}
+
+// overridden retrieves a copy of the configuration with its overrides applied to its tables,
+// along with the errors for the overrides that do not match a table. Configurations produced
+// by Merge have no overrides, since they are applied when merging.
+func (c Configuration) overridden() (Configuration, ValidationErrors) {
+	if len(c.Overrides) == 0 {
+		return c, nil
+	}
+
+	var errs ValidationErrors
+	overridden := c
+	overridden.Tables = slices.Clone(c.Tables)
+	overridden.Overrides = nil
+	for i, o := range c.Overrides {
+		if !o.apply(overridden.Tables) {
+			errs = append(errs, &ValidationError{
+				Table:    strings.TrimSpace(o.TypeName),
+				Position: c.Position(overridePath(i)),
+				Err:      ErrUnmatchedOverride,
+			})
+		}
+	}
+	return overridden, errs
+}
+
+// Validate ensures that the configuration produces valid tables, reporting every
+// problem found as a ValidationErrors. Overrides are applied to the tables before
+// they are validated.
+func (c Configuration) Validate() error {
+	c, errs := c.overridden()
+	aliases := make(map[string]string)
+	for i, t := range c.Tables {
+		table := t.label(i)
+		report := func(err error) {
+			errs = append(errs, &ValidationError{
+				Table:    table,
+				Position: c.Position(tablePath(i)),
+				Err:      err,
+			})
+		}
+
+		if strings.TrimSpace(t.TypeName) == "" {
+			report(ErrMissingTypeName)
+		}
+
+		if strings.TrimSpace(t.Name) == "" {
+			report(ErrMissingTableName)
+		}
+
+		if alias := strings.TrimSpace(t.Alias); alias == "" {
+			report(ErrMissingTableAlias)
+		} else if _, ok := aliases[alias]; ok {
+			report(ErrDuplicateTableAlias)
+		} else {
+			aliases[alias] = table
+		}
+
+		if len(t.Columns) == 0 {
+			report(ErrMissingColumns)
+			continue
+		}
+
+		primaryKeys, nonPrimaryKeys := 0, 0
+		names, fields := make(map[string]bool), make(map[string]bool)
+		for j, col := range t.Columns {
+			column := col.label(j)
+			report := func(err error) {
+				errs = append(errs, &ValidationError{
+					Table:    table,
+					Column:   column,
+					Position: c.Position(columnPath(i, j)),
+					Err:      err,
+				})
+			}
+
+			if name := strings.TrimSpace(col.Name); name == "" {
+				report(ErrMissingColumnName)
+			} else if names[name] {
+				report(ErrDuplicateColumnName)
+			} else {
+				names[name] = true
+			}
+
+			if field := strings.TrimSpace(col.Field); field == "" {
+				report(ErrMissingColumnField)
+			} else if fields[field] {
+				report(ErrDuplicateColumnField)
+			} else {
+				fields[field] = true
+			}
+
+			if col.FieldStrategy != FieldStrategyStructField && col.FieldStrategy != FieldStrategyMethod {
+				report(ErrInvalidFieldStrategy)
+			}
+
+			if fieldType := strings.TrimSpace(col.FieldType); fieldType != "" && !isType(fieldType) {
+				report(ErrInvalidFieldType)
+			}
+
+			if col.PrimaryKey {
+				primaryKeys++
+			} else {
+				nonPrimaryKeys++
+			}
+		}
+
+		if primaryKeys == 0 {
+			report(ErrMissingPrimaryKey)
+		}
+
+		if nonPrimaryKeys == 0 {
+			report(ErrMissingNonPrimaryKey)
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
 }
 
-// AsMetadata converts the configuration to metadata mappings.
+// isType determines if the provided string is a valid Go type expression.
+func isType(s string) bool {
+	expr, err := parser.ParseExpr(s)
+	if err != nil {
+		return false
+	}
+
+	var check func(ast.Expr) bool
+	check = func(e ast.Expr) bool {
+		switch e := e.(type) {
+		case *ast.Ident:
+			return true
+		case *ast.SelectorExpr:
+			_, ok := e.X.(*ast.Ident)
+			return ok
+		case *ast.StarExpr:
+			return check(e.X)
+		case *ast.ArrayType:
+			return check(e.Elt)
+		case *ast.MapType:
+			return check(e.Key) && check(e.Value)
+		case *ast.ChanType:
+			return check(e.Value)
+		case *ast.IndexExpr:
+			return check(e.X) && check(e.Index)
+		case *ast.IndexListExpr:
+			for _, index := range e.Indices {
+				if !check(index) {
+					return false
+				}
+			}
+			return check(e.X)
+		case *ast.FuncType, *ast.InterfaceType, *ast.StructType:
+			return true
+		case *ast.ParenExpr:
+			return check(e.X)
+		}
+		return false
+	}
+
+	return check(expr)
+}
+
+// AsMetadata converts the configuration to metadata mappings, after applying its
+// overrides. Overrides that do not match a table are ignored.
 func (c Configuration) AsMetadata() []Table {
+	c, _ = c.overridden()
 	var tables []Table
 	for _, t := range c.Tables {
-		var table Table
-		table.SetTypeName(t.TypeName)
-		table.SetName(t.Name)
-		table.SetAlias(t.Alias)
-		for _, c := range t.Columns {
-			var column Column
-			column.SetField(c.Field)
-			column.SetName(c.Name)
-			column.SetFieldType(c.FieldType)
-			column.SetStrategy(c.FieldStrategy)
-			column.SetPrimaryKey(c.PrimaryKey)
-			if err := table.AddColumn(column); err != nil {
-				continue
-			}
-		}
+		table, _ := t.asMetadata(false)
 		tables = append(tables, table)
 	}
 	return tables
 }
 
+// FromTables replaces the tables within the configuration with the configurations
+// of the provided tables, which is the inverse of AsMetadata.
+func (c *Configuration) FromTables(tables ...Table) {
+	c.Tables = make([]TableConfiguration, 0, len(tables))
+	for _, t := range tables {
+		c.Tables = append(c.Tables, t.AsConfiguration())
+	}
+	c.file, c.positions = "", nil
+}
+
+// AsMetadataStrict converts the configuration to metadata mappings, failing with
+// the errors reported by Validate instead of dropping invalid columns.
+func (c Configuration) AsMetadataStrict() ([]Table, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	c, _ = c.overridden()
+	var tables []Table
+	for _, t := range c.Tables {
+		table, err := t.asMetadata(true)
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+	return tables, nil
+}
+
 // TableConfiguration represents the configuration used to construct
 // a single table mapping.
 type TableConfiguration struct {
-	TypeName string                `json:"typeName" yaml:"typeName"`
-	Name     string                `json:"name" yaml:"name"`
-	Alias    string                `json:"alias" yaml:"alias"`
-	Columns  []ColumnConfiguration `json:"columns" yaml:"columns"`
+	TypeName string                `json:"typeName" yaml:"typeName" toml:"typeName" hcl:"typeName,optional" jsonschema:"required"`
+	Name     string                `json:"name" yaml:"name" toml:"name" hcl:"name,optional" jsonschema:"required"`
+	Alias    string                `json:"alias" yaml:"alias" toml:"alias" hcl:"alias,optional" jsonschema:"required"`
+	Columns  []ColumnConfiguration `json:"columns" yaml:"columns" toml:"columns" hcl:"column,block"`
+	Include  []string              `json:"include,omitempty" yaml:"include,omitempty" toml:"-"`
+}
+
+// label retrieves the name used to identify the table within errors.
+func (t TableConfiguration) label(index int) string {
+	if name := strings.TrimSpace(t.Name); name != "" {
+		return name
+	}
+	if typeName := strings.TrimSpace(t.TypeName); typeName != "" {
+		return typeName
+	}
+	return tablePath(index)
+}
+
+// asMetadata converts the table configuration to a table mapping, optionally
+// failing when a column cannot be added.
+func (t TableConfiguration) asMetadata(strict bool) (Table, error) {
+	var table Table
+	table.SetTypeName(t.TypeName)
+	table.SetName(t.Name)
+	table.SetAlias(t.Alias)
+	for _, c := range t.Columns {
+		var column Column
+		column.SetField(c.Field)
+		column.SetName(c.Name)
+		column.SetFieldType(c.FieldType)
+		column.SetStrategy(c.FieldStrategy)
+		column.SetPrimaryKey(c.PrimaryKey)
+		if err := table.AddColumn(column); err != nil {
+			if strict {
+				return Table{}, err
+			}
+			continue
+		}
+	}
+	return table, nil
+}
+
+// TableOverride represents the configuration used to patch the name or alias of
+// a table mapping defined in another configuration, such as for a specific environment.
+type TableOverride struct {
+	TypeName string `json:"typeName" yaml:"typeName" toml:"typeName" hcl:"typeName" jsonschema:"required"`
+	Name     string `json:"name,omitempty" yaml:"name,omitempty" toml:"name,omitempty" hcl:"name,optional"`
+	Alias    string `json:"alias,omitempty" yaml:"alias,omitempty" toml:"alias,omitempty" hcl:"alias,optional"`
+}
+
+// apply applies the override to the provided tables with its type name, and indicates if
+// any of them matched.
+func (o TableOverride) apply(tables []TableConfiguration) bool {
+	typeName := strings.TrimSpace(o.TypeName)
+	matched := false
+	for i := range tables {
+		if strings.TrimSpace(tables[i].TypeName) != typeName {
+			continue
+		}
+		matched = true
+		if name := strings.TrimSpace(o.Name); name != "" {
+			tables[i].Name = name
+		}
+		if alias := strings.TrimSpace(o.Alias); alias != "" {
+			tables[i].Alias = alias
+		}
+	}
+	return matched
 }
 
 // ColumnConfiguration represents the configuration used to construct
 // a single column mapping.
 type ColumnConfiguration struct {
-	Name          string        `json:"name" yaml:"name"`
-	Field         string        `json:"field" yaml:"field"`
-	FieldType     string        `json:"fieldType" yaml:"fieldType"`
-	FieldStrategy FieldStrategy `json:"fieldStrategy" yaml:"fieldStrategy"`
-	PrimaryKey    bool          `json:"primaryKey" yaml:"primaryKey"`
+	Name          string        `json:"name" yaml:"name" toml:"name" hcl:"name,optional" jsonschema:"required"`
+	Field         string        `json:"field" yaml:"field" toml:"field" hcl:"field,optional" jsonschema:"required"`
+	FieldType     string        `json:"fieldType" yaml:"fieldType" toml:"fieldType" hcl:"fieldType,optional"`
+	FieldStrategy FieldStrategy `json:"fieldStrategy" yaml:"fieldStrategy" toml:"fieldStrategy" hcl:"fieldStrategy,optional" jsonschema:"required,enum=struct_field|method"`
+	PrimaryKey    bool          `json:"primaryKey" yaml:"primaryKey" toml:"primaryKey" hcl:"primaryKey,optional"`
+}
+
+// label retrieves the name used to identify the column within errors.
+func (c ColumnConfiguration) label(index int) string {
+	if name := strings.TrimSpace(c.Name); name != "" {
+		return name
+	}
+	if field := strings.TrimSpace(c.Field); field != "" {
+		return field
+	}
+	return fmt.Sprintf("columns[%d]", index)
 }
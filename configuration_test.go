@@ -1,6 +1,7 @@
 package morph_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/freerware/morph"
@@ -52,3 +53,439 @@ func (s *ConfigurationTestSuite) TestAsMetadata() {
 	s.True(tables[0].Columns()[0].UsingStructFieldStrategy())
 	s.Equal(config.Tables[0].Columns[0].PrimaryKey, tables[0].Columns()[0].PrimaryKey())
 }
+
+func (s *ConfigurationTestSuite) TestValidate() {
+	valid := func() morph.Configuration {
+		return morph.Configuration{
+			Tables: []morph.TableConfiguration{
+				{
+					TypeName: "example.User",
+					Name:     "user",
+					Alias:    "U",
+					Columns: []morph.ColumnConfiguration{
+						{Name: "id", Field: "ID", FieldType: "int", FieldStrategy: morph.FieldStrategyStructField, PrimaryKey: true},
+						{Name: "username", Field: "Username", FieldType: "*string", FieldStrategy: morph.FieldStrategyMethod},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		mutation func(*morph.Configuration)
+		errs     []error
+	}{
+		{
+			name:     "Valid",
+			mutation: func(c *morph.Configuration) {},
+		},
+		{
+			name: "MissingTypeName",
+			mutation: func(c *morph.Configuration) {
+				c.Tables[0].TypeName = " "
+			},
+			errs: []error{morph.ErrMissingTypeName},
+		},
+		{
+			name: "MissingNameAndAlias",
+			mutation: func(c *morph.Configuration) {
+				c.Tables[0].Name = ""
+				c.Tables[0].Alias = ""
+			},
+			errs: []error{morph.ErrMissingTableName, morph.ErrMissingTableAlias},
+		},
+		{
+			name: "MissingColumns",
+			mutation: func(c *morph.Configuration) {
+				c.Tables[0].Columns = nil
+			},
+			errs: []error{morph.ErrMissingColumns},
+		},
+		{
+			name: "MissingPrimaryKey",
+			mutation: func(c *morph.Configuration) {
+				c.Tables[0].Columns[0].PrimaryKey = false
+			},
+			errs: []error{morph.ErrMissingPrimaryKey},
+		},
+		{
+			name: "MissingNonPrimaryKey",
+			mutation: func(c *morph.Configuration) {
+				c.Tables[0].Columns[1].PrimaryKey = true
+			},
+			errs: []error{morph.ErrMissingNonPrimaryKey},
+		},
+		{
+			name: "DuplicateColumns",
+			mutation: func(c *morph.Configuration) {
+				c.Tables[0].Columns = append(c.Tables[0].Columns, c.Tables[0].Columns[1])
+			},
+			errs: []error{morph.ErrDuplicateColumnName, morph.ErrDuplicateColumnField},
+		},
+		{
+			name: "MissingColumnNameAndField",
+			mutation: func(c *morph.Configuration) {
+				c.Tables[0].Columns[1].Name = ""
+				c.Tables[0].Columns[1].Field = ""
+			},
+			errs: []error{morph.ErrMissingColumnName, morph.ErrMissingColumnField},
+		},
+		{
+			name: "InvalidFieldStrategy",
+			mutation: func(c *morph.Configuration) {
+				c.Tables[0].Columns[1].FieldStrategy = "getter"
+			},
+			errs: []error{morph.ErrInvalidFieldStrategy},
+		},
+		{
+			name: "InvalidFieldType",
+			mutation: func(c *morph.Configuration) {
+				c.Tables[0].Columns[1].FieldType = "string("
+			},
+			errs: []error{morph.ErrInvalidFieldType},
+		},
+		{
+			name: "ValidFieldTypes",
+			mutation: func(c *morph.Configuration) {
+				c.Tables[0].Columns[0].FieldType = "map[string][]*time.Time"
+				c.Tables[0].Columns[1].FieldType = "sql.Null[string]"
+			},
+		},
+		{
+			name: "DuplicateTableAlias",
+			mutation: func(c *morph.Configuration) {
+				t := c.Tables[0]
+				t.TypeName = "example.Admin"
+				t.Name = "admin"
+				c.Tables = append(c.Tables, t)
+			},
+			errs: []error{morph.ErrDuplicateTableAlias},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// arrange.
+			config := valid()
+			test.mutation(&config)
+
+			// action.
+			err := config.Validate()
+
+			// assert.
+			if len(test.errs) == 0 {
+				s.Require().NoError(err)
+				return
+			}
+			var errs morph.ValidationErrors
+			s.Require().ErrorAs(err, &errs)
+			s.Require().Len(errs, len(test.errs))
+			for _, expected := range test.errs {
+				s.ErrorIs(err, expected)
+			}
+		})
+	}
+}
+
+func (s *ConfigurationTestSuite) TestValidate_Positions() {
+	tests := []struct {
+		name     string
+		loader   morph.BytesLoader
+		data     string
+		messages []string
+	}{
+		{
+			name:   "YAML",
+			loader: morph.YAMLLoader{},
+			data: `tables:
+  - typeName: example.User
+    name: user
+    alias: U
+    columns:
+      - name: id
+        field: ID
+        fieldStrategy: struct_field
+        primaryKey: true
+      - name: username
+        field: Username
+        fieldStrategy: getter
+`,
+			messages: []string{
+				`morph: 10:9: table "user", column "username": column field strategy must be "struct_field" or "method"`,
+			},
+		},
+		{
+			name:   "JSON",
+			loader: morph.JSONLoader{},
+			data: `{
+  "tables": [
+    {
+      "name": "user",
+      "alias": "U",
+      "columns": [
+        {"name": "id", "field": "ID", "fieldStrategy": "struct_field", "primaryKey": true},
+        {"name": "id", "field": "Username", "fieldStrategy": "method"}
+      ]
+    }
+  ]
+}`,
+			messages: []string{
+				`morph: 3:5: table "user": must have table type name to evaluate`,
+				`morph: 8:9: table "user", column "id": column name must be unique within the table`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// arrange.
+			config, err := test.loader.LoadBytes([]byte(test.data))
+			s.Require().NoError(err)
+
+			// action.
+			err = config.Validate()
+
+			// assert.
+			s.Require().Error(err)
+			s.Equal(strings.Join(test.messages, "\n"), err.Error())
+		})
+	}
+}
+
+func (s *ConfigurationTestSuite) TestValidate_File() {
+	// arrange.
+	config, err := morph.Load("./test_config.yaml")
+	s.Require().NoError(err)
+
+	// action.
+	err = config.Validate()
+
+	// assert.
+	s.Require().Error(err)
+	s.Equal(`morph: ./test_config.yaml:2:5: table "user": must have table type name to evaluate`, err.Error())
+}
+
+func (s *ConfigurationTestSuite) TestPosition_Appended() {
+	// arrange.
+	data := `tables:
+  - typeName: example.User
+    name: user
+    columns:
+      - name: id
+        field: ID
+        fieldStrategy: struct_field
+        primaryKey: true
+`
+	config, err := morph.YAMLLoader{}.LoadBytes([]byte(data))
+	s.Require().NoError(err)
+	expected := []morph.TableConfiguration{{
+		TypeName: "example.User",
+		Name:     "user",
+		Columns: []morph.ColumnConfiguration{
+			{Name: "id", Field: "ID", FieldStrategy: morph.FieldStrategyStructField, PrimaryKey: true},
+		},
+	}}
+
+	// action.
+	config.Tables = append(config.Tables, morph.TableConfiguration{TypeName: "example.Ship", Name: "ship", Alias: "S"})
+	err = config.Validate()
+
+	// assert.
+	s.Equal(expected, config.Tables[:1])
+	s.Equal(morph.Position{Line: 2, Column: 5}, config.Position("tables[0]"))
+	s.Equal(morph.Position{}, config.Position("tables[1]"))
+	s.Require().Error(err)
+	s.Contains(err.Error(), `morph: 2:5: table "user": must have table alias`)
+}
+
+func (s *ConfigurationTestSuite) TestAsMetadataStrict() {
+	// arrange.
+	config := morph.Configuration{
+		Tables: []morph.TableConfiguration{
+			{
+				TypeName: "example.User",
+				Name:     "user",
+				Alias:    "U",
+				Columns: []morph.ColumnConfiguration{
+					{Name: "id", Field: "ID", FieldStrategy: morph.FieldStrategyStructField, PrimaryKey: true},
+					{Name: "username", Field: "Username", FieldStrategy: morph.FieldStrategyStructField},
+				},
+			},
+		},
+	}
+
+	// action.
+	tables, err := config.AsMetadataStrict()
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().Len(tables, 1)
+	s.Len(tables[0].Columns(), 2)
+}
+
+func (s *ConfigurationTestSuite) TestAsMetadataStrict_Invalid() {
+	// arrange.
+	config := morph.Configuration{
+		Tables: []morph.TableConfiguration{
+			{
+				TypeName: "example.User",
+				Name:     "user",
+				Alias:    "U",
+				Columns: []morph.ColumnConfiguration{
+					{Name: "id", Field: "ID", FieldStrategy: morph.FieldStrategyStructField, PrimaryKey: true},
+					{Name: "id", Field: "Username", FieldStrategy: morph.FieldStrategyStructField},
+				},
+			},
+		},
+	}
+
+	// action.
+	tables, err := config.AsMetadataStrict()
+
+	// assert.
+	s.Require().ErrorIs(err, morph.ErrDuplicateColumnName)
+	s.Nil(tables)
+	s.Len(config.AsMetadata()[0].Columns(), 1)
+}
+
+func (s *ConfigurationTestSuite) TestMerge() {
+	// arrange.
+	users := morph.Configuration{
+		Tables: []morph.TableConfiguration{{TypeName: "example.User", Name: "users", Alias: "U"}},
+	}
+	ships := morph.Configuration{
+		Tables:    []morph.TableConfiguration{{TypeName: "example.Ship", Name: "ships", Alias: "S"}},
+		Overrides: []morph.TableOverride{{TypeName: "example.User", Alias: "P"}},
+	}
+	staging := morph.Configuration{
+		Overrides: []morph.TableOverride{{TypeName: "example.User", Name: "staging.users"}},
+	}
+
+	// action.
+	merged, err := morph.Merge(users, ships, staging)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal([]morph.TableConfiguration{
+		{TypeName: "example.User", Name: "staging.users", Alias: "P"},
+		{TypeName: "example.Ship", Name: "ships", Alias: "S"},
+	}, merged.Tables)
+	s.Empty(merged.Overrides)
+	s.Equal("U", users.Tables[0].Alias)
+}
+
+func (s *ConfigurationTestSuite) TestAsMetadata_Overrides() {
+	// arrange.
+	config, err := morph.YAMLLoader{}.LoadBytes([]byte(strings.Join([]string{
+		"tables:",
+		"  - typeName: example.User",
+		"    name: users",
+		"    alias: U",
+		"    columns:",
+		"      - name: id",
+		"        field: ID",
+		"        fieldStrategy: struct_field",
+		"        primaryKey: true",
+		"      - name: username",
+		"        field: Username",
+		"        fieldStrategy: struct_field",
+		"overrides:",
+		"  - typeName: example.User",
+		"    name: staging.users",
+	}, "\n")))
+	s.Require().NoError(err)
+
+	// action.
+	tables, err := config.AsMetadataStrict()
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal("staging.users", tables[0].Name())
+	s.Equal("staging.users", config.AsMetadata()[0].Name())
+	s.Equal("users", config.Tables[0].Name)
+}
+
+func (s *ConfigurationTestSuite) TestValidate_UnmatchedOverride() {
+	// arrange.
+	config := morph.Configuration{
+		Tables:    []morph.TableConfiguration{{TypeName: "example.User", Name: "users", Alias: "U"}},
+		Overrides: []morph.TableOverride{{TypeName: "example.Missing", Name: "missing"}},
+	}
+
+	// action.
+	err := config.Validate()
+
+	// assert.
+	s.Require().ErrorIs(err, morph.ErrUnmatchedOverride)
+	s.ErrorContains(err, `table "example.Missing": override must match the type name of a table`)
+}
+
+func (s *ConfigurationTestSuite) TestMerge_Errors() {
+	tests := []struct {
+		name    string
+		configs []morph.Configuration
+		err     error
+	}{
+		{
+			name: "DuplicateTypeName",
+			configs: []morph.Configuration{
+				{Tables: []morph.TableConfiguration{{TypeName: "example.User", Name: "users"}}},
+				{Tables: []morph.TableConfiguration{{TypeName: "example.User", Name: "people"}}},
+			},
+			err: morph.ErrDuplicateTypeName,
+		},
+		{
+			name: "DuplicateTableName",
+			configs: []morph.Configuration{
+				{Tables: []morph.TableConfiguration{{TypeName: "example.User", Name: "users"}}},
+				{Tables: []morph.TableConfiguration{{TypeName: "example.Admin", Name: "users"}}},
+			},
+			err: morph.ErrDuplicateTableName,
+		},
+		{
+			name: "DuplicateTableName_AfterOverride",
+			configs: []morph.Configuration{
+				{Tables: []morph.TableConfiguration{{TypeName: "example.User", Name: "users"}, {TypeName: "example.Admin", Name: "admins"}}},
+				{Overrides: []morph.TableOverride{{TypeName: "example.Admin", Name: "users"}}},
+			},
+			err: morph.ErrDuplicateTableName,
+		},
+		{
+			name: "UnmatchedOverride",
+			configs: []morph.Configuration{
+				{Tables: []morph.TableConfiguration{{TypeName: "example.User", Name: "users"}}},
+				{Overrides: []morph.TableOverride{{TypeName: "example.Missing", Name: "missing"}}},
+			},
+			err: morph.ErrUnmatchedOverride,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// action.
+			_, err := morph.Merge(test.configs...)
+
+			// assert.
+			s.Require().ErrorIs(err, test.err)
+		})
+	}
+}
+
+func (s *ConfigurationTestSuite) TestFromTables() {
+	// arrange.
+	model, err := morph.Reflect(&TestModel{})
+	s.Require().NoError(err)
+	another, err := morph.Reflect(AnotherTestModel{})
+	s.Require().NoError(err)
+	var config morph.Configuration
+
+	// action.
+	config.FromTables(model, another)
+
+	// assert.
+	s.Require().Len(config.Tables, 2)
+	s.Equal(model.AsConfiguration(), config.Tables[0])
+	s.Equal(another.AsConfiguration(), config.Tables[1])
+	s.Equal([]morph.Table{model, another}, config.AsMetadata())
+}
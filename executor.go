@@ -0,0 +1,343 @@
+package morph
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Defines the various errors that can occur when executing queries.
+var (
+	// ErrNotFound represents an error encountered when retrieving an entity that has no row.
+	ErrNotFound = errors.New("morph: entity not found")
+
+	// ErrNoRowsAffected represents an error encountered when a statement does not affect any rows,
+	// such as when updating or deleting an entity that has no row.
+	ErrNoRowsAffected = errors.New("morph: no rows affected")
+
+	// ErrInvalidEntity represents an error encountered when an entity that must be modified
+	// is not provided as a non-nil pointer to a struct.
+	ErrInvalidEntity = errors.New("morph: entity must be a non-nil pointer to a struct")
+
+	// ErrUnsupportedDialect represents an error encountered when a dialect is not within Dialects.
+	ErrUnsupportedDialect = errors.New("morph: unsupported dialect")
+)
+
+// Querier represents the methods shared by *sql.DB, *sql.Tx and *sql.Conn that are
+// used to execute queries.
+type Querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// ExecutorOption is a function that configures the executor options.
+type ExecutorOption func(*ExecutorOptions)
+
+// ExecutorOptions represents the options used when executing queries.
+type ExecutorOptions struct {
+	// Dialect is the name of the dialect within Dialects used to generate the queries.
+	Dialect string
+
+	// QueryOptions are the additional options used to generate the queries.
+	QueryOptions []QueryOption
+
+	// Returning indicates if generated keys are retrieved using a RETURNING clause
+	// rather than the last insert ID of the result.
+	Returning bool
+}
+
+// WithDialect generates queries for the dialect with the provided name within Dialects.
+// Generated keys are retrieved using a RETURNING clause for Postgres, since its drivers
+// do not provide the last insert ID.
+func WithDialect(name string) ExecutorOption {
+	return func(o *ExecutorOptions) {
+		o.Dialect = name
+		o.Returning = name == "postgres"
+	}
+}
+
+// WithQueryOptions generates queries using the provided options.
+func WithQueryOptions(options ...QueryOption) ExecutorOption {
+	return func(o *ExecutorOptions) {
+		o.QueryOptions = append(o.QueryOptions, options...)
+	}
+}
+
+// WithReturning retrieves generated keys using a RETURNING clause rather than the last insert ID.
+func WithReturning() ExecutorOption {
+	return func(o *ExecutorOptions) {
+		o.Returning = true
+	}
+}
+
+// Executor executes the queries generated for tables using a *sql.DB, *sql.Tx or *sql.Conn.
+type Executor struct {
+	q       Querier
+	options ExecutorOptions
+	query   []QueryOption
+}
+
+// NewExecutor constructs an executor that executes queries using the provided querier.
+// It fails when the dialect provided is not within Dialects.
+func NewExecutor(q Querier, options ...ExecutorOption) (*Executor, error) {
+	e := &Executor{q: q}
+	for _, opt := range options {
+		opt(&e.options)
+	}
+
+	if e.options.Dialect != "" {
+		dialect, ok := Dialects[e.options.Dialect]
+		if !ok {
+			return nil, fmt.Errorf("%w %q", ErrUnsupportedDialect, e.options.Dialect)
+		}
+		e.query = append(e.query, dialect...)
+	}
+	e.query = append(e.query, e.options.QueryOptions...)
+	return e, nil
+}
+
+// Insert inserts a row for the provided entity into the table. When the table has a single
+// primary key column and the entity has the zero value for it, the key is considered to be
+// generated by the database, so it is omitted from the INSERT and populated afterwards.
+func (e *Executor) Insert(ctx context.Context, t Table, entity any) error {
+	key, generated, err := generatedKey(ctx, t, entity)
+	if err != nil {
+		return err
+	}
+	if !generated {
+		query, args, err := t.InsertQueryWithArgs(entity, e.queryOptions(ctx)...)
+		if err != nil {
+			return err
+		}
+		return e.exec(ctx, query, args)
+	}
+
+	omitKey := WithEmptyPredicate(func(c Column, _ any) bool { return c.Name() == key.Name() })
+	opts := append(e.queryOptions(ctx), WithoutEmptyValues(entity), omitKey)
+	if e.options.Returning {
+		opts = append(opts, withReturning(key.Name()))
+	}
+	query, args, err := t.InsertQueryWithArgs(entity, opts...)
+	if err != nil {
+		return err
+	}
+
+	dest, set, err := destination(entity, key)
+	if err != nil {
+		return err
+	}
+	if e.options.Returning {
+		if err := e.q.QueryRowContext(ctx, query, args...).Scan(dest); err != nil {
+			return err
+		}
+		set()
+		return nil
+	}
+
+	result, err := e.q.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	if err := assignInt(dest, id); err != nil {
+		return err
+	}
+	set()
+	return nil
+}
+
+// Update updates the row of the provided entity within the table, failing with
+// ErrNoRowsAffected when there is no row for the entity. MySQL reports only the rows
+// that change by default, so the error is also returned when the row already holds
+// the values of the entity unless the connection sets clientFoundRows.
+func (e *Executor) Update(ctx context.Context, t Table, entity any) error {
+	query, args, err := t.UpdateQueryWithArgs(entity, e.queryOptions(ctx)...)
+	if err != nil {
+		return err
+	}
+	return e.exec(ctx, query, args)
+}
+
+// Delete deletes the row of the provided entity from the table, failing with
+// ErrNoRowsAffected when there is no row for the entity.
+func (e *Executor) Delete(ctx context.Context, t Table, entity any) error {
+	query, args, err := t.DeleteQueryWithArgs(entity, e.queryOptions(ctx)...)
+	if err != nil {
+		return err
+	}
+	return e.exec(ctx, query, args)
+}
+
+// Get retrieves the row of the table with the primary key of the provided entity, and copies
+// its columns into the entity, failing with ErrNotFound when there is no such row. Columns
+// mapped to methods are provided to the Set method of the same name, if any, and otherwise
+// discarded.
+func (e *Executor) Get(ctx context.Context, t Table, entity any) error {
+	query, args, err := t.SelectQueryWithArgs(entity, e.queryOptions(ctx)...)
+	if err != nil {
+		return err
+	}
+	return e.queryRow(ctx, t, entity, query, args)
+}
+
+// queryRow executes the provided query, and copies the columns of the row retrieved into the
+// provided entity, failing with ErrNotFound when no row is retrieved.
+func (e *Executor) queryRow(ctx context.Context, t Table, entity any, query string, args []any) error {
+	dest, set, err := destinations(t, entity)
+	if err != nil {
+		return err
+	}
+
+	err = e.q.QueryRowContext(ctx, query, args...).Scan(dest...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+	set()
+	return nil
+}
+
+// queryOptions retrieves a copy of the options used to generate queries, which can be
+// appended to without modifying the options of the executor, providing the context to hooks.
+func (e *Executor) queryOptions(ctx context.Context) []QueryOption {
+	return append(append([]QueryOption(nil), e.query...), WithContext(ctx))
+}
+
+// exec executes the provided statement, failing with ErrNoRowsAffected when no rows are affected.
+func (e *Executor) exec(ctx context.Context, query string, args []any) error {
+	return e.execRows(ctx, query, args, 1)
+}
+
+// execRows executes the provided statement, failing with ErrNoRowsAffected when fewer than
+// the provided number of rows are affected.
+func (e *Executor) execRows(ctx context.Context, query string, args []any, count int) error {
+	result, err := e.q.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected < int64(count) {
+		return ErrNoRowsAffected
+	}
+	return nil
+}
+
+// exists indicates if the table has a row with the provided key, which is either an entity,
+// the value of a single primary key column, or the values of the primary key columns ordered
+// by column name.
+func (e *Executor) exists(ctx context.Context, t Table, key any) (bool, error) {
+	args, err := t.keyValues(ctx, t.FindColumns(func(c Column) bool { return c.PrimaryKey() }), key)
+	if err != nil {
+		return false, err
+	}
+	opts := append(e.queryOptions(ctx), withoutNamedParameters())
+	query, err := t.ExistsQuery(opts...)
+	if err != nil {
+		return false, err
+	}
+	if query, args, err = t.withArgs(existsTmpl, query, args, opts...); err != nil {
+		return false, err
+	}
+
+	var exists bool
+	if err := e.q.QueryRowContext(ctx, query, args...).Scan(&exists); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+// generatedKey retrieves the primary key column of the table, and indicates if it is
+// generated by the database, which is when it is the only primary key column and the
+// provided entity has the zero value for it.
+func generatedKey(ctx context.Context, t Table, entity any) (Column, bool, error) {
+	keys := t.FindColumns(func(c Column) bool { return c.PrimaryKey() })
+	if len(keys) != 1 {
+		return Column{}, false, nil
+	}
+	result, err := t.evaluateContext(ctx, entity)
+	if err != nil {
+		return Column{}, false, err
+	}
+	return keys[0], EmptyIfZero(keys[0], result[keys[0].Name()]), nil
+}
+
+// destinations retrieves the destinations that the columns of the table, in column order,
+// are scanned into for the provided entity, along with a function that provides the values
+// scanned for method columns to their setters.
+func destinations(t Table, entity any) ([]any, func(), error) {
+	columns := t.Columns()
+	dest := make([]any, 0, len(columns))
+	sets := make([]func(), 0, len(columns))
+	for _, col := range columns {
+		d, set, err := destination(entity, col)
+		if err != nil {
+			return nil, nil, err
+		}
+		dest = append(dest, d)
+		sets = append(sets, set)
+	}
+	return dest, func() {
+		for _, set := range sets {
+			set()
+		}
+	}, nil
+}
+
+// destination retrieves the destination that the provided column is scanned into for the
+// provided entity, which is the field itself for struct field columns. For method columns,
+// it is a value of the parameter type of the Set method of the same name, which the returned
+// function provides to the setter, or otherwise a value that is discarded.
+func destination(entity any, col Column) (any, func(), error) {
+	v := reflect.ValueOf(entity)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil, nil, ErrInvalidEntity
+	}
+
+	if col.UsingStructFieldStrategy() {
+		field := v.Elem().FieldByName(col.Field())
+		if !field.IsValid() || !field.CanSet() {
+			return nil, nil, fmt.Errorf("morph: no settable field %q for column %q", col.Field(), col.Name())
+		}
+		return field.Addr().Interface(), func() {}, nil
+	}
+
+	setter := v.MethodByName("Set" + col.Field())
+	if !setter.IsValid() || setter.Type().NumIn() != 1 {
+		return new(any), func() {}, nil
+	}
+	value := reflect.New(setter.Type().In(0))
+	return value.Interface(), func() { setter.Call([]reflect.Value{value.Elem()}) }, nil
+}
+
+// assignInt assigns the provided integer to the provided destination, which is a pointer
+// to an integer, or a pointer to a pointer to one.
+func assignInt(dest any, value int64) error {
+	v := reflect.ValueOf(dest).Elem()
+	if v.Kind() == reflect.Ptr {
+		v.Set(reflect.New(v.Type().Elem()))
+		v = v.Elem()
+	}
+
+	switch {
+	case v.CanInt() && !v.OverflowInt(value):
+		v.SetInt(value)
+	case v.CanUint() && value >= 0 && !v.OverflowUint(uint64(value)):
+		v.SetUint(uint64(value))
+	case v.Kind() == reflect.Interface:
+		v.Set(reflect.ValueOf(value))
+	default:
+		return fmt.Errorf("morph: unable to assign generated key %d to %s", value, v.Type())
+	}
+	return nil
+}
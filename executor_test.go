@@ -0,0 +1,340 @@
+package morph_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/freerware/morph"
+	"github.com/stretchr/testify/suite"
+	_ "modernc.org/sqlite"
+)
+
+// Account is an entity with a generated key, a nullable column and a method column.
+type Account struct {
+	ID       int64
+	Username string
+	Email    *string
+	nickname string
+}
+
+// Nickname retrieves the nickname of the account.
+func (a *Account) Nickname() string {
+	return a.nickname
+}
+
+// SetNickname modifies the nickname of the account.
+func (a *Account) SetNickname(nickname string) {
+	a.nickname = nickname
+}
+
+// LineItem is an entity with a composite key.
+type LineItem struct {
+	OrderID  int64
+	Line     int
+	Quantity int
+}
+
+// executorTables configures the tables of the entities used to test the executor.
+var executorTables = morph.Configuration{Tables: []morph.TableConfiguration{
+	{
+		TypeName: "morph_test.Account",
+		Name:     "accounts",
+		Alias:    "A",
+		Columns: []morph.ColumnConfiguration{
+			{Name: "id", Field: "ID", FieldStrategy: morph.FieldStrategyStructField, PrimaryKey: true},
+			{Name: "username", Field: "Username", FieldStrategy: morph.FieldStrategyStructField},
+			{Name: "email", Field: "Email", FieldStrategy: morph.FieldStrategyStructField},
+			{Name: "nickname", Field: "Nickname", FieldStrategy: morph.FieldStrategyMethod},
+		},
+	},
+	{
+		TypeName: "morph_test.LineItem",
+		Name:     "line_items",
+		Alias:    "L",
+		Columns: []morph.ColumnConfiguration{
+			{Name: "order_id", Field: "OrderID", FieldStrategy: morph.FieldStrategyStructField, PrimaryKey: true},
+			{Name: "line", Field: "Line", FieldStrategy: morph.FieldStrategyStructField, PrimaryKey: true},
+			{Name: "quantity", Field: "Quantity", FieldStrategy: morph.FieldStrategyStructField},
+		},
+	},
+}}
+
+type ExecutorTestSuite struct {
+	suite.Suite
+
+	ctx      context.Context
+	db       *sql.DB
+	accounts morph.Table
+	items    morph.Table
+	sut      *morph.Executor
+}
+
+func TestExecutorTestSuite(t *testing.T) {
+	suite.Run(t, new(ExecutorTestSuite))
+}
+
+func (s *ExecutorTestSuite) SetupTest() {
+	tables := morph.Must(executorTables.AsMetadataStrict())
+	s.accounts, s.items = tables[0], tables[1]
+	s.ctx = context.Background()
+
+	var err error
+	s.db, err = sql.Open("sqlite", ":memory:")
+	s.Require().NoError(err)
+	s.db.SetMaxOpenConns(1)
+	for _, statement := range []string{
+		"CREATE TABLE accounts (id INTEGER PRIMARY KEY AUTOINCREMENT, username TEXT NOT NULL, email TEXT, nickname TEXT)",
+		"CREATE TABLE line_items (order_id INTEGER NOT NULL, line INTEGER NOT NULL, quantity INTEGER NOT NULL, PRIMARY KEY (order_id, line))",
+		"INSERT INTO accounts (id, username, nickname) VALUES (41, 'existing', 'ex')",
+	} {
+		_, err := s.db.Exec(statement)
+		s.Require().NoError(err)
+	}
+
+	s.sut, err = morph.NewExecutor(s.db, morph.WithDialect("sqlite"))
+	s.Require().NoError(err)
+}
+
+func (s *ExecutorTestSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *ExecutorTestSuite) TestNewExecutor_UnsupportedDialect() {
+	// action.
+	_, err := morph.NewExecutor(s.db, morph.WithDialect("oracle"))
+
+	// assert.
+	s.ErrorIs(err, morph.ErrUnsupportedDialect)
+	s.EqualError(err, `morph: unsupported dialect "oracle"`)
+}
+
+func (s *ExecutorTestSuite) TestInsert_GeneratedKey() {
+	// arrange.
+	email := "fr33r@example.com"
+	account := Account{Username: "fr33r", Email: &email}
+	account.SetNickname("jon")
+
+	// action.
+	err := s.sut.Insert(s.ctx, s.accounts, &account)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal(int64(42), account.ID)
+
+	actual := Account{ID: account.ID}
+	s.Require().NoError(s.sut.Get(s.ctx, s.accounts, &actual))
+	s.Equal(account, actual)
+}
+
+func (s *ExecutorTestSuite) TestInsert_Returning() {
+	// arrange.
+	sut, err := morph.NewExecutor(s.db, morph.WithDialect("sqlite"), morph.WithReturning())
+	s.Require().NoError(err)
+	account := Account{Username: "fr33r"}
+
+	// action.
+	err = sut.Insert(s.ctx, s.accounts, &account)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal(int64(42), account.ID)
+}
+
+func (s *ExecutorTestSuite) TestInsert_ProvidedKey() {
+	// arrange.
+	account := Account{ID: 7, Username: "fr33r"}
+	item := LineItem{OrderID: 7, Line: 1, Quantity: 3}
+
+	// action.
+	accountErr := s.sut.Insert(s.ctx, s.accounts, account)
+	itemErr := s.sut.Insert(s.ctx, s.items, &item)
+
+	// assert.
+	s.Require().NoError(accountErr)
+	s.Require().NoError(itemErr)
+
+	actual := LineItem{OrderID: 7, Line: 1}
+	s.Require().NoError(s.sut.Get(s.ctx, s.items, &actual))
+	s.Equal(item, actual)
+}
+
+func (s *ExecutorTestSuite) TestInsert_InvalidEntity() {
+	// action.
+	err := s.sut.Insert(s.ctx, s.accounts, Account{Username: "fr33r"})
+
+	// assert.
+	s.ErrorIs(err, morph.ErrInvalidEntity)
+}
+
+func (s *ExecutorTestSuite) TestInsert_Conflict() {
+	// action.
+	err := s.sut.Insert(s.ctx, s.accounts, &Account{ID: 41, Username: "duplicate"})
+
+	// assert.
+	s.Error(err)
+}
+
+func (s *ExecutorTestSuite) TestGet() {
+	// arrange.
+	account := Account{ID: 41}
+
+	// action.
+	err := s.sut.Get(s.ctx, s.accounts, &account)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal("existing", account.Username)
+	s.Nil(account.Email)
+	s.Equal("ex", account.Nickname())
+}
+
+func (s *ExecutorTestSuite) TestGet_NotFound() {
+	// action.
+	err := s.sut.Get(s.ctx, s.accounts, &Account{ID: 404})
+
+	// assert.
+	s.ErrorIs(err, morph.ErrNotFound)
+}
+
+func (s *ExecutorTestSuite) TestGet_InvalidEntity() {
+	// action.
+	err := s.sut.Get(s.ctx, s.accounts, Account{ID: 41})
+
+	// assert.
+	s.ErrorIs(err, morph.ErrInvalidEntity)
+}
+
+func (s *ExecutorTestSuite) TestGet_Canceled() {
+	// arrange.
+	ctx, cancel := context.WithCancel(s.ctx)
+	cancel()
+
+	// action.
+	err := s.sut.Get(ctx, s.accounts, &Account{ID: 41})
+
+	// assert.
+	s.ErrorIs(err, context.Canceled)
+}
+
+func (s *ExecutorTestSuite) TestDelete() {
+	// arrange.
+	account := Account{ID: 41}
+
+	// action.
+	err := s.sut.Delete(s.ctx, s.accounts, &account)
+
+	// assert.
+	s.Require().NoError(err)
+	s.ErrorIs(s.sut.Get(s.ctx, s.accounts, &account), morph.ErrNotFound)
+	s.ErrorIs(s.sut.Delete(s.ctx, s.accounts, &account), morph.ErrNoRowsAffected)
+}
+
+func (s *ExecutorTestSuite) TestTransaction() {
+	// arrange.
+	tx, err := s.db.BeginTx(s.ctx, nil)
+	s.Require().NoError(err)
+	sut, err := morph.NewExecutor(tx, morph.WithDialect("sqlite"))
+	s.Require().NoError(err)
+
+	// action.
+	err = sut.Insert(s.ctx, s.accounts, &Account{Username: "rolled back"})
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().NoError(tx.Rollback())
+	s.ErrorIs(s.sut.Get(s.ctx, s.accounts, &Account{ID: 42}), morph.ErrNotFound)
+}
+
+func (s *ExecutorTestSuite) TestUpdate() {
+	// arrange.
+	email := "fr33r@example.com"
+	account := Account{ID: 41, Username: "fr33r", Email: &email}
+	account.SetNickname("jon")
+
+	// action.
+	err := s.sut.Update(s.ctx, s.accounts, &account)
+
+	// assert.
+	s.Require().NoError(err)
+
+	actual := Account{ID: 41}
+	s.Require().NoError(s.sut.Get(s.ctx, s.accounts, &actual))
+	s.Equal(account, actual)
+}
+
+func (s *ExecutorTestSuite) TestUpdate_NoRowsAffected() {
+	// action.
+	err := s.sut.Update(s.ctx, s.accounts, &Account{ID: 404, Username: "missing"})
+
+	// assert.
+	s.ErrorIs(err, morph.ErrNoRowsAffected)
+}
+
+func (s *ExecutorTestSuite) TestExec_Errors() {
+	// arrange.
+	errExec := errors.New("exec failed")
+	errResult := errors.New("unsupported")
+	tests := []struct {
+		name string
+		q    *fakeQuerier
+		err  error
+	}{
+		{name: "Exec", q: &fakeQuerier{err: errExec}, err: errExec},
+		{name: "RowsAffected", q: &fakeQuerier{result: fakeResult{err: errResult}}, err: errResult},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// arrange.
+			sut, err := morph.NewExecutor(test.q)
+			s.Require().NoError(err)
+
+			// action.
+			updateErr := sut.Update(s.ctx, s.accounts, &Account{ID: 41})
+			deleteErr := sut.Delete(s.ctx, s.accounts, &Account{ID: 41})
+			insertErr := sut.Insert(s.ctx, s.accounts, &Account{})
+
+			// assert.
+			s.ErrorIs(updateErr, test.err)
+			s.ErrorIs(deleteErr, test.err)
+			s.ErrorIs(insertErr, test.err)
+		})
+	}
+}
+
+// fakeQuerier is a querier that records the statement executed and provides a fixed result.
+type fakeQuerier struct {
+	morph.Querier
+
+	query  string
+	args   []any
+	result fakeResult
+	err    error
+}
+
+// ExecContext records the provided statement and retrieves the fixed result.
+func (q *fakeQuerier) ExecContext(_ context.Context, query string, args ...any) (sql.Result, error) {
+	q.query, q.args = query, args
+	if q.err != nil {
+		return nil, q.err
+	}
+	return q.result, nil
+}
+
+// fakeResult is a result with fixed rows affected, which fails for both methods when err is set.
+type fakeResult struct {
+	affected int64
+	err      error
+}
+
+// LastInsertId retrieves the fixed rows affected as the ID.
+func (r fakeResult) LastInsertId() (int64, error) {
+	return r.affected, r.err
+}
+
+// RowsAffected retrieves the fixed rows affected.
+func (r fakeResult) RowsAffected() (int64, error) {
+	return r.affected, r.err
+}
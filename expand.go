@@ -0,0 +1,228 @@
+package morph
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Defines the various errors that can occur when expanding configurations.
+var (
+	// ErrUnsetVariable represents an error encountered when a configuration value references
+	// an environment variable that is not set and has no default.
+	ErrUnsetVariable = errors.New("morph: environment variable is not set")
+
+	// ErrInvalidInterpolation represents an error encountered when a configuration value
+	// contains a malformed environment variable reference.
+	ErrInvalidInterpolation = errors.New("morph: environment variable reference is malformed")
+
+	// ErrInvalidInclude represents an error encountered when a column fragment cannot be included.
+	ErrInvalidInclude = errors.New("morph: unable to include column fragment")
+
+	// errIncludeOutside represents an error encountered when an included column fragment is
+	// not within the directory of the including configuration.
+	errIncludeOutside = errors.New("path must be relative and within the directory of the configuration")
+
+	// errIncludeDisabled represents an error encountered when a configuration loaded without
+	// a directory includes a column fragment.
+	errIncludeDisabled = errors.New("includes require a directory to resolve fragments against")
+)
+
+// fragment represents a reusable set of columns included by tables.
+type fragment struct {
+	Columns []ColumnConfiguration `json:"columns" yaml:"columns"`
+}
+
+// includer resolves and reads the column fragment at the provided path.
+type includer func(path string) (resolved string, data []byte, err error)
+
+// osIncluder reads column fragments from the operating system, relative to the provided directory.
+// Fragments outside of the directory are rejected, and every fragment is rejected when the
+// directory is empty.
+func osIncluder(dir string) includer {
+	return func(p string) (string, []byte, error) {
+		if dir == "" {
+			return p, nil, errIncludeDisabled
+		}
+		if !filepath.IsLocal(p) {
+			return p, nil, errIncludeOutside
+		}
+		p = filepath.Join(dir, p)
+		data, err := os.ReadFile(p)
+		return p, data, err
+	}
+}
+
+// fsIncluder reads column fragments from the provided file system, relative to the provided directory.
+// Fragments outside of the directory are rejected.
+func fsIncluder(fsys fs.FS, dir string) includer {
+	return func(p string) (string, []byte, error) {
+		if !fs.ValidPath(path.Clean(p)) {
+			return p, nil, errIncludeOutside
+		}
+		p = path.Join(dir, p)
+		data, err := fs.ReadFile(fsys, p)
+		return p, data, err
+	}
+}
+
+// expand appends the columns of the fragments included by each table and then, when
+// requested, interpolates the environment variables referenced within the values of the
+// configuration, including the paths of the fragments.
+func (c *Configuration) expand(include includer, env bool) error {
+	resolve := interpolateInto
+	if !env {
+		resolve = func(*string) error { return nil }
+	}
+
+	var errs ValidationErrors
+	for i := range c.Tables {
+		t := &c.Tables[i]
+		report := func(column string, p Position, err error) {
+			errs = append(errs, &ValidationError{Table: t.label(i), Column: column, Position: p, Err: err})
+		}
+
+		for _, inc := range t.Include {
+			p := inc
+			if err := resolve(&p); err != nil {
+				report("", c.Position(tablePath(i)), err)
+				continue
+			}
+			file, f, err := readFragment(include, p)
+			if err != nil {
+				report("", c.Position(tablePath(i)), fmt.Errorf("%w %q: %s", ErrInvalidInclude, p, err))
+				continue
+			}
+			if c.positions == nil {
+				c.positions = make(positions)
+			}
+			for _, col := range f.Columns {
+				c.positions[columnPath(i, len(t.Columns))] = Position{File: file}
+				t.Columns = append(t.Columns, col)
+			}
+		}
+		t.Include = nil
+
+		for _, s := range []*string{&t.TypeName, &t.Name, &t.Alias} {
+			if err := resolve(s); err != nil {
+				report("", c.Position(tablePath(i)), err)
+			}
+		}
+
+		for j := range t.Columns {
+			col := &t.Columns[j]
+			strategy := string(col.FieldStrategy)
+			for _, s := range []*string{&col.Name, &col.Field, &col.FieldType, &strategy} {
+				if err := resolve(s); err != nil {
+					report(col.label(j), c.Position(columnPath(i, j)), err)
+				}
+			}
+			col.FieldStrategy = FieldStrategy(strategy)
+		}
+	}
+
+	for i := range c.Overrides {
+		o := &c.Overrides[i]
+		for _, s := range []*string{&o.TypeName, &o.Name, &o.Alias} {
+			if err := resolve(s); err != nil {
+				errs = append(errs, &ValidationError{Table: o.TypeName, Position: c.Position(overridePath(i)), Err: err})
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// readFragment reads and decodes the column fragment at the provided path, which is
+// JSON when it has the .json extension and YAML otherwise.
+func readFragment(include includer, p string) (file string, f fragment, err error) {
+	var data []byte
+	if file, data, err = include(p); err != nil {
+		return
+	}
+	if extension(p) == "json" {
+		err = json.Unmarshal(data, &f)
+	} else {
+		err = yaml.Unmarshal(data, &f)
+	}
+	return
+}
+
+// interpolateInto replaces the value of the provided string with its interpolated value.
+func interpolateInto(s *string) error {
+	v, err := interpolate(*s)
+	if err != nil {
+		return err
+	}
+	*s = v
+	return nil
+}
+
+// interpolate replaces the environment variable references within the provided value.
+// A reference of the form ${NAME} is replaced with the value of the variable, and one
+// of the form ${NAME:-default} uses the default when the variable is unset or empty.
+// A literal dollar sign is written as $$.
+func interpolate(s string) (string, error) {
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		if s[i] != '$' || i+1 == len(s) {
+			b = append(b, s[i])
+			continue
+		}
+
+		switch s[i+1] {
+		case '$':
+			b = append(b, '$')
+			i++
+		case '{':
+			end := strings.IndexByte(s[i:], '}')
+			if end < 0 {
+				return "", fmt.Errorf("%w: %s", ErrInvalidInterpolation, s[i:])
+			}
+			end += i
+
+			name, def, hasDefault := strings.Cut(s[i+2:end], ":-")
+			if !isVariableName(name) {
+				return "", fmt.Errorf("%w: %s", ErrInvalidInterpolation, s[i:end+1])
+			}
+
+			if v, ok := os.LookupEnv(name); ok && (v != "" || !hasDefault) {
+				b = append(b, v...)
+			} else if hasDefault {
+				b = append(b, def...)
+			} else {
+				return "", fmt.Errorf("%w: %s", ErrUnsetVariable, name)
+			}
+			i = end
+		default:
+			b = append(b, '$')
+		}
+	}
+	return string(b), nil
+}
+
+// isVariableName determines if the provided string is a valid environment variable name.
+func isVariableName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case r == '_', r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z':
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
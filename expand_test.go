@@ -0,0 +1,351 @@
+package morph_test
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/freerware/morph"
+	"github.com/stretchr/testify/suite"
+)
+
+type ExpandTestSuite struct {
+	suite.Suite
+
+	dir string
+}
+
+func TestExpandTestSuite(t *testing.T) {
+	suite.Run(t, new(ExpandTestSuite))
+}
+
+func (s *ExpandTestSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+}
+
+func (s *ExpandTestSuite) write(name, contents string) string {
+	path := filepath.Join(s.dir, name)
+	s.Require().NoError(os.MkdirAll(filepath.Dir(path), 0o700))
+	s.Require().NoError(os.WriteFile(path, []byte(contents), 0o600))
+	return path
+}
+
+func (s *ExpandTestSuite) TestInterpolation() {
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{name: "Plain", value: "users", expected: "users"},
+		{name: "Variable", value: "${MORPH_SCHEMA}.users", expected: "staging.users"},
+		{name: "DefaultUnused", value: "${MORPH_SCHEMA:-public}.users", expected: "staging.users"},
+		{name: "DefaultUnset", value: "${MORPH_MISSING:-public}.users", expected: "public.users"},
+		{name: "DefaultEmpty", value: "${MORPH_EMPTY:-public}.users", expected: "public.users"},
+		{name: "EmptyWithoutDefault", value: "${MORPH_EMPTY}users", expected: "users"},
+		{name: "EmptyDefault", value: "${MORPH_MISSING:-}users", expected: "users"},
+		{name: "Multiple", value: "${MORPH_TENANT}_${MORPH_SCHEMA}", expected: "acme_staging"},
+		{name: "Escaped", value: "$${MORPH_SCHEMA}", expected: "${MORPH_SCHEMA}"},
+		{name: "LoneDollar", value: "price$", expected: "price$"},
+	}
+
+	s.T().Setenv("MORPH_SCHEMA", "staging")
+	s.T().Setenv("MORPH_TENANT", "acme")
+	s.T().Setenv("MORPH_EMPTY", "")
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// arrange.
+			data := `{"tables": [{"typeName": "example.User", "name": "` + test.value + `"}]}`
+
+			// action.
+			c, err := morph.JSONLoader{Interpolate: true}.LoadBytes([]byte(data))
+
+			// assert.
+			s.Require().NoError(err)
+			s.Equal(test.expected, c.Tables[0].Name)
+		})
+	}
+}
+
+func (s *ExpandTestSuite) TestInterpolation_AllValues() {
+	// arrange.
+	s.T().Setenv("MORPH_PREFIX", "acme_")
+	path := s.write("users.yaml", strings.Join([]string{
+		"tables:",
+		"  - typeName: ${MORPH_PACKAGE:-example}.User",
+		"    name: ${MORPH_PREFIX}users",
+		"    alias: ${MORPH_ALIAS:-U}",
+		"    columns:",
+		"      - name: ${MORPH_PREFIX}id",
+		"        field: ID",
+		"        fieldType: ${MORPH_ID_TYPE:-int}",
+		"        fieldStrategy: ${MORPH_STRATEGY:-struct_field}",
+		"overrides:",
+		"  - typeName: example.User",
+		"    alias: ${MORPH_ALIAS:-V}",
+	}, "\n"))
+
+	// action.
+	c, err := morph.YAMLLoader{Interpolate: true}.Load(path)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal("example.User", c.Tables[0].TypeName)
+	s.Equal("acme_users", c.Tables[0].Name)
+	s.Equal("U", c.Tables[0].Alias)
+	s.Equal(morph.ColumnConfiguration{
+		Name:          "acme_id",
+		Field:         "ID",
+		FieldType:     "int",
+		FieldStrategy: morph.FieldStrategyStructField,
+	}, c.Tables[0].Columns[0])
+	s.Equal("V", c.Overrides[0].Alias)
+}
+
+func (s *ExpandTestSuite) TestInterpolation_Errors() {
+	// arrange.
+	path := s.write("users.yaml", strings.Join([]string{
+		"tables:",
+		"  - typeName: example.User",
+		"    name: ${MORPH_MISSING}",
+		"    columns:",
+		"      - name: id",
+		"        field: ${1D}",
+		"      - name: name",
+		"        field: ${MORPH_NAME",
+	}, "\n"))
+
+	// action.
+	_, err := morph.YAMLLoader{Interpolate: true}.Load(path)
+
+	// assert.
+	s.Require().ErrorIs(err, morph.ErrUnsetVariable)
+	s.Require().ErrorIs(err, morph.ErrInvalidInterpolation)
+	s.ErrorContains(err, path+`:2:5: table "${MORPH_MISSING}": environment variable is not set: MORPH_MISSING`)
+	s.ErrorContains(err, path+`:5:9: table "${MORPH_MISSING}", column "id": environment variable reference is malformed: ${1D}`)
+	s.ErrorContains(err, path+`:7:9: table "${MORPH_MISSING}", column "name": environment variable reference is malformed: ${MORPH_NAME`)
+}
+
+func (s *ExpandTestSuite) TestInterpolation_Disabled() {
+	// arrange.
+	s.T().Setenv("MORPH_SCHEMA", "staging")
+	path := s.write("users.yaml", strings.Join([]string{
+		"tables:",
+		"  - typeName: example.User",
+		"    name: ${MORPH_SCHEMA}.users",
+		"    alias: $$U",
+		"    columns:",
+		"      - name: ${MORPH_MISSING}",
+		"        field: ID",
+	}, "\n"))
+
+	// action.
+	c, err := morph.Load(path)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal("${MORPH_SCHEMA}.users", c.Tables[0].Name)
+	s.Equal("$$U", c.Tables[0].Alias)
+	s.Equal("${MORPH_MISSING}", c.Tables[0].Columns[0].Name)
+}
+
+func (s *ExpandTestSuite) TestInterpolation_Registered() {
+	// arrange.
+	s.T().Setenv("MORPH_SCHEMA", "staging")
+	path := s.write("users.json", `{"tables": [{"typeName": "example.User", "name": "${MORPH_SCHEMA}.users", "alias": "$${U}"}]}`)
+	morph.RegisterLoader("json", morph.JSONLoader{Interpolate: true})
+	defer morph.RegisterLoader("json", morph.JSONLoader{})
+
+	// action.
+	c, err := morph.Load(path)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal("staging.users", c.Tables[0].Name)
+	s.Equal("${U}", c.Tables[0].Alias)
+}
+
+func (s *ExpandTestSuite) TestInclude() {
+	// arrange.
+	s.T().Setenv("MORPH_AUDIT", "audit")
+	s.write("shared/audit.yaml", strings.Join([]string{
+		"columns:",
+		"  - name: created_at",
+		"    field: CreatedAt",
+		"    fieldStrategy: struct_field",
+		"  - name: ${MORPH_AUDIT}_updated_at",
+		"    field: UpdatedAt",
+		"    fieldStrategy: struct_field",
+	}, "\n"))
+	s.write("shared/version.json", `{"columns": [{"name": "version", "field": "Version", "fieldStrategy": "struct_field"}]}`)
+	path := s.write("users.yaml", strings.Join([]string{
+		"tables:",
+		"  - typeName: example.User",
+		"    name: users",
+		"    alias: U",
+		"    include:",
+		"      - shared/audit.yaml",
+		"      - ${MORPH_SHARED:-shared}/version.json",
+		"    columns:",
+		"      - name: id",
+		"        field: ID",
+		"        fieldStrategy: struct_field",
+		"        primaryKey: true",
+	}, "\n"))
+
+	// action.
+	c, err := morph.YAMLLoader{Interpolate: true}.Load(path)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().Len(c.Tables[0].Columns, 4)
+	s.Empty(c.Tables[0].Include)
+	s.Equal("id", c.Tables[0].Columns[0].Name)
+	s.Equal("created_at", c.Tables[0].Columns[1].Name)
+	s.Equal("audit_updated_at", c.Tables[0].Columns[2].Name)
+	s.Equal("version", c.Tables[0].Columns[3].Name)
+	s.NoError(c.Validate())
+}
+
+func (s *ExpandTestSuite) TestInclude_Located() {
+	// arrange.
+	s.write("audit.yaml", "columns:\n  - name: id\n    field: CreatedAt\n    fieldStrategy: struct_field\n")
+	path := s.write("users.yaml", strings.Join([]string{
+		"tables:",
+		"  - typeName: example.User",
+		"    name: users",
+		"    alias: U",
+		"    include: [audit.yaml]",
+		"    columns:",
+		"      - name: id",
+		"        field: ID",
+		"        fieldStrategy: struct_field",
+		"        primaryKey: true",
+	}, "\n"))
+
+	// action.
+	c, err := morph.Load(path)
+	s.Require().NoError(err)
+	err = c.Validate()
+
+	// assert.
+	s.Require().ErrorIs(err, morph.ErrDuplicateColumnName)
+	s.ErrorContains(err, filepath.Join(s.dir, "audit.yaml")+`: table "users", column "id"`)
+}
+
+func (s *ExpandTestSuite) TestInclude_MissingFragment() {
+	// arrange.
+	path := s.write("users.json", `{"tables": [{"typeName": "example.User", "include": ["missing.yaml"]}]}`)
+
+	// action.
+	_, err := morph.Load(path)
+
+	// assert.
+	s.Require().ErrorIs(err, morph.ErrInvalidInclude)
+	s.ErrorContains(err, `table "example.User": unable to include column fragment "missing.yaml"`)
+}
+
+func (s *ExpandTestSuite) TestInclude_InvalidFragment() {
+	// arrange.
+	s.write("audit.json", "{")
+	path := s.write("users.json", `{"tables": [{"typeName": "example.User", "include": ["audit.json"]}]}`)
+
+	// action.
+	_, err := morph.Load(path)
+
+	// assert.
+	s.Require().ErrorIs(err, morph.ErrInvalidInclude)
+}
+
+func (s *ExpandTestSuite) TestInclude_FS() {
+	// arrange.
+	fsys := fstest.MapFS{
+		"mappings/users.json":        &fstest.MapFile{Data: []byte(`{"tables": [{"typeName": "example.User", "include": ["shared/audit.yaml"]}]}`)},
+		"mappings/shared/audit.yaml": &fstest.MapFile{Data: []byte("columns:\n  - name: created_at\n    field: CreatedAt\n")},
+	}
+
+	// action.
+	c, err := morph.LoadFS(fsys, "mappings/users.json")
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().Len(c.Tables[0].Columns, 1)
+	s.Equal("created_at", c.Tables[0].Columns[0].Name)
+}
+
+func (s *ExpandTestSuite) TestInclude_Bytes() {
+	// arrange.
+	s.write("audit.yaml", "columns:\n  - name: created_at\n    field: CreatedAt\n")
+	sut := morph.YAMLLoader{IncludeDir: s.dir}
+
+	// action.
+	c, err := sut.LoadBytes([]byte("tables:\n  - typeName: example.User\n    include: [audit.yaml]\n"))
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().Len(c.Tables[0].Columns, 1)
+	s.Equal("created_at", c.Tables[0].Columns[0].Name)
+}
+
+func (s *ExpandTestSuite) TestInclude_BytesWithoutDir() {
+	// arrange.
+	s.write("audit.yaml", "columns:\n  - name: created_at\n    field: CreatedAt\n")
+	wd, err := os.Getwd()
+	s.Require().NoError(err)
+	s.Require().NoError(os.Chdir(s.dir))
+	defer os.Chdir(wd)
+
+	// action.
+	_, bytesErr := morph.YAMLLoader{}.LoadBytes([]byte("tables:\n  - typeName: example.User\n    include: [audit.yaml]\n"))
+	_, readerErr := morph.LoadReader(strings.NewReader(`{"tables": [{"typeName": "example.User", "include": ["audit.yaml"]}]}`), "json")
+
+	// assert.
+	s.Require().ErrorIs(bytesErr, morph.ErrInvalidInclude)
+	s.ErrorContains(bytesErr, `unable to include column fragment "audit.yaml": includes require a directory to resolve fragments against`)
+	s.ErrorIs(readerErr, morph.ErrInvalidInclude)
+}
+
+func (s *ExpandTestSuite) TestInclude_OutsideDir() {
+	// arrange.
+	secret := s.write("secret.yaml", "columns:\n  - name: password\n    field: Password\n")
+	fsys := fstest.MapFS{
+		"mappings/users.json": &fstest.MapFile{Data: []byte(`{"tables": [{"typeName": "example.User", "include": ["../secret.yaml"]}]}`)},
+		"secret.yaml":         &fstest.MapFile{Data: []byte("columns:\n  - name: password\n    field: Password\n")},
+	}
+	tests := []struct {
+		name string
+		load func() (morph.Configuration, error)
+	}{
+		{
+			name: "Absolute",
+			load: func() (morph.Configuration, error) {
+				return morph.Load(s.write("mappings/users.json", `{"tables": [{"typeName": "example.User", "include": [`+strconv.Quote(secret)+`]}]}`))
+			},
+		},
+		{
+			name: "Parent",
+			load: func() (morph.Configuration, error) {
+				return morph.Load(s.write("mappings/users.yaml", "tables:\n  - typeName: example.User\n    include: [../secret.yaml]\n"))
+			},
+		},
+		{
+			name: "FS",
+			load: func() (morph.Configuration, error) {
+				return morph.LoadFS(fsys, "mappings/users.json")
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// action.
+			_, err := test.load()
+
+			// assert.
+			s.Require().ErrorIs(err, morph.ErrInvalidInclude)
+			s.ErrorContains(err, "path must be relative and within the directory of the configuration")
+		})
+	}
+}
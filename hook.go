@@ -0,0 +1,126 @@
+package morph
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// Defines the global hooks, which are run for all tables.
+var (
+	globalHooksMu sync.RWMutex
+	globalHooks   []Hook
+)
+
+// Operation represents the generation of a query or the evaluation of an object that
+// hooks are run around. Hooks may modify the operation to change its outcome.
+type Operation struct {
+	// Context is the context provided using WithContext when generating queries, including
+	// when evaluating objects to derive their arguments, and the background context otherwise.
+	Context context.Context
+
+	// Table is the table the operation is performed for.
+	Table *Table
+
+	// Statement is the name of the template of the query being generated, such as
+	// insertQuery or selectByKeysQuery. It is empty when evaluating an object.
+	Statement string
+
+	// Query is the query generated, which may be rewritten after it is rendered.
+	Query string
+
+	// Args are the arguments derived for the query, which may be inspected or transformed
+	// after they are derived.
+	Args []any
+
+	// Object is the object being evaluated.
+	Object any
+
+	// Result is the result of evaluating the object, which may be transformed after the
+	// object is evaluated.
+	Result EvaluationResult
+}
+
+// Hook represents functions that are run around the operations of tables, any of which may
+// be nil. Returning an error from any of them fails the operation with that error, which
+// allows hooks to veto operations.
+type Hook struct {
+	// BeforeQuery is run before a query is rendered.
+	BeforeQuery func(op *Operation) error
+
+	// AfterQuery is run after a query is rendered, and may rewrite the query. For the methods
+	// that generate queries along with their arguments, it is run once the named parameters
+	// of the query have been replaced by placeholders.
+	AfterQuery func(op *Operation) error
+
+	// AfterArgs is run after the arguments of a query are derived, for the methods that
+	// generate queries along with their arguments. It may rewrite the query, or inspect
+	// and transform the arguments.
+	AfterArgs func(op *Operation) error
+
+	// BeforeEvaluate is run before an object is evaluated.
+	BeforeEvaluate func(op *Operation) error
+
+	// AfterEvaluate is run after an object is evaluated, and may transform the result.
+	AfterEvaluate func(op *Operation) error
+}
+
+// AddGlobalHooks adds the provided hooks to those run for all tables, which run before
+// the hooks of the tables themselves.
+func AddGlobalHooks(hooks ...Hook) {
+	globalHooksMu.Lock()
+	defer globalHooksMu.Unlock()
+	globalHooks = append(slices.Clip(globalHooks), hooks...)
+}
+
+// ClearGlobalHooks removes the hooks run for all tables.
+func ClearGlobalHooks() {
+	globalHooksMu.Lock()
+	defer globalHooksMu.Unlock()
+	globalHooks = nil
+}
+
+// AddHooks adds the provided hooks to those run for the table.
+func (t *Table) AddHooks(hooks ...Hook) {
+	t.hooks = append(slices.Clip(t.hooks), hooks...)
+}
+
+// Hooks retrieves the hooks run for the table, excluding the global hooks.
+func (t *Table) Hooks() []Hook {
+	return slices.Clone(t.hooks)
+}
+
+// allHooks retrieves the global hooks followed by the hooks of the table.
+func (t *Table) allHooks() []Hook {
+	globalHooksMu.RLock()
+	defer globalHooksMu.RUnlock()
+	if len(globalHooks) == 0 {
+		return t.hooks
+	}
+	return append(slices.Clip(globalHooks), t.hooks...)
+}
+
+// before runs the provided function of each of the provided hooks in order.
+func before(hooks []Hook, op *Operation, fn func(Hook) func(*Operation) error) error {
+	for _, h := range hooks {
+		if f := fn(h); f != nil {
+			if err := f(op); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// after runs the provided function of each of the provided hooks in reverse order, so
+// that the hooks that run first before an operation run last after it.
+func after(hooks []Hook, op *Operation, fn func(Hook) func(*Operation) error) error {
+	for i := len(hooks) - 1; i >= 0; i-- {
+		if f := fn(hooks[i]); f != nil {
+			if err := f(op); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,275 @@
+package morph_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/freerware/morph"
+	"github.com/stretchr/testify/suite"
+	_ "modernc.org/sqlite"
+)
+
+// requestIDKey is the key of the request ID within a context.
+type requestIDKey struct{}
+
+// commenter is a hook that appends a sqlcommenter style comment containing the request ID
+// carried by the context of the operation.
+var commenter = morph.Hook{
+	AfterQuery: func(op *morph.Operation) error {
+		if id, ok := op.Context.Value(requestIDKey{}).(string); ok {
+			op.Query = strings.TrimSuffix(op.Query, ";") + fmt.Sprintf(" /*request_id='%s'*/;", id)
+		}
+		return nil
+	},
+}
+
+type HookTestSuite struct {
+	suite.Suite
+
+	sut morph.Table
+}
+
+func TestHookTestSuite(t *testing.T) {
+	suite.Run(t, new(HookTestSuite))
+}
+
+func (s *HookTestSuite) SetupTest() {
+	s.sut = morph.Must(executorTables.AsMetadataStrict())[0]
+}
+
+func (s *HookTestSuite) TearDownTest() {
+	morph.ClearGlobalHooks()
+}
+
+func (s *HookTestSuite) TestAfterQuery() {
+	// arrange.
+	s.sut.AddHooks(commenter)
+	ctx := context.WithValue(context.Background(), requestIDKey{}, "42")
+
+	// action.
+	query, args, err := s.sut.DeleteQueryWithArgs(&Account{ID: 1}, morph.WithContext(ctx))
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal("DELETE FROM accounts WHERE 1=1 AND id = ? /*request_id='42'*/;", query)
+	s.Equal([]any{int64(1)}, args)
+	s.Len(s.sut.Hooks(), 1)
+}
+
+func (s *HookTestSuite) TestAfterQuery_ColonInComment() {
+	// arrange.
+	s.sut.AddHooks(morph.Hook{
+		AfterQuery: func(op *morph.Operation) error {
+			op.Query = strings.TrimSuffix(op.Query, ";") + " /*route='/users/:id'*/;"
+			return nil
+		},
+	})
+
+	// action.
+	query, args, err := s.sut.UpdateQueryWithArgs(&Account{ID: 1, Username: "fr33r"}, morph.Dialects["postgres"]...)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal("UPDATE accounts AS A SET email = $1, nickname = $2, username = $3 WHERE 1=1 AND A.id = $4 /*route='/users/:id'*/;", query)
+	s.Equal([]any{nil, "", "fr33r", int64(1)}, args)
+}
+
+func (s *HookTestSuite) TestBeforeQuery_Veto() {
+	// arrange.
+	errReadOnly := errors.New("read only")
+	s.sut.AddHooks(morph.Hook{
+		BeforeQuery: func(op *morph.Operation) error {
+			if !strings.HasPrefix(op.Statement, "select") {
+				return errReadOnly
+			}
+			return nil
+		},
+	})
+
+	// action.
+	_, selectErr := s.sut.SelectQuery()
+	_, updateErr := s.sut.UpdateQuery()
+	_, _, deleteErr := s.sut.DeleteByKeysQueryWithArgs([]any{1})
+
+	// assert.
+	s.NoError(selectErr)
+	s.ErrorIs(updateErr, errReadOnly)
+	s.ErrorIs(deleteErr, errReadOnly)
+}
+
+func (s *HookTestSuite) TestAfterArgs() {
+	// arrange.
+	var statements []string
+	s.sut.AddHooks(morph.Hook{
+		AfterArgs: func(op *morph.Operation) error {
+			statements = append(statements, op.Statement)
+			for idx, arg := range op.Args {
+				if username, ok := arg.(string); ok {
+					op.Args[idx] = strings.Repeat("*", len(username))
+				}
+			}
+			return nil
+		},
+	})
+
+	// action.
+	_, insertArgs, insertErr := s.sut.InsertQueryWithArgs(&Account{ID: 1, Username: "fr33r"})
+	_, whereArgs, whereErr := s.sut.SelectWhereQueryWithArgs(morph.Criteria{"Username": "fr33r"}, nil, morph.Page{})
+
+	// assert.
+	s.Require().NoError(insertErr)
+	s.Require().NoError(whereErr)
+	s.Equal([]any{nil, int64(1), "", "*****"}, insertArgs)
+	s.Equal([]any{"*****"}, whereArgs)
+	s.Equal([]string{"insertQuery", "selectWhereQuery"}, statements)
+}
+
+func (s *HookTestSuite) TestEvaluate() {
+	// arrange.
+	errBanned := errors.New("banned")
+	s.sut.AddHooks(morph.Hook{
+		BeforeEvaluate: func(op *morph.Operation) error {
+			if op.Object.(*Account).Username == "vader" {
+				return errBanned
+			}
+			return nil
+		},
+		AfterEvaluate: func(op *morph.Operation) error {
+			op.Result["username"] = strings.ToUpper(op.Result["username"].(string))
+			return nil
+		},
+	})
+
+	// action.
+	_, args, err := s.sut.UpdateQueryWithArgs(&Account{ID: 1, Username: "fr33r"}, morph.WithPlaceholder("$", true))
+	_, vetoErr := s.sut.Evaluate(&Account{ID: 2, Username: "vader"})
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal([]any{nil, "", "FR33R", int64(1)}, args)
+	s.ErrorIs(vetoErr, errBanned)
+}
+
+func (s *HookTestSuite) TestGlobalHooks_Order() {
+	// arrange.
+	var calls []string
+	hook := func(name string) morph.Hook {
+		return morph.Hook{
+			BeforeQuery: func(*morph.Operation) error {
+				calls = append(calls, "before "+name)
+				return nil
+			},
+			AfterQuery: func(*morph.Operation) error {
+				calls = append(calls, "after "+name)
+				return nil
+			},
+		}
+	}
+	morph.AddGlobalHooks(hook("global"))
+	s.sut.AddHooks(hook("table"))
+	other := morph.Must(executorTables.AsMetadataStrict())[1]
+
+	// action.
+	_, err := s.sut.SelectQuery()
+	_, otherErr := other.SelectQuery()
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().NoError(otherErr)
+	s.Equal([]string{"before global", "before table", "after table", "after global", "before global", "after global"}, calls)
+}
+
+func (s *HookTestSuite) TestExecutor_Context() {
+	// arrange.
+	q := &fakeQuerier{result: fakeResult{affected: 1}}
+	executor, err := morph.NewExecutor(q, morph.WithDialect("postgres"))
+	s.Require().NoError(err)
+	morph.AddGlobalHooks(commenter)
+	ctx := context.WithValue(context.Background(), requestIDKey{}, "42")
+
+	// action.
+	err = executor.Delete(ctx, s.sut, &Account{ID: 1})
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal("DELETE FROM accounts WHERE 1=1 AND id = $1 /*request_id='42'*/;", q.query)
+}
+
+func (s *HookTestSuite) TestEvaluate_Context() {
+	// arrange.
+	db := s.database()
+	executor, err := morph.NewExecutor(db, morph.WithDialect("sqlite"))
+	s.Require().NoError(err)
+	repository, err := morph.NewRepository[Account](executor, s.sut)
+	s.Require().NoError(err)
+	work, err := morph.NewUnitOfWork(db, morph.Must(executorTables.AsMetadataStrict()), morph.WithExecutorOptions(morph.WithDialect("sqlite")))
+	s.Require().NoError(err)
+	var ids []any
+	morph.AddGlobalHooks(morph.Hook{
+		BeforeEvaluate: func(op *morph.Operation) error {
+			ids = append(ids, op.Context.Value(requestIDKey{}))
+			return nil
+		},
+	})
+	ctx := context.WithValue(context.Background(), requestIDKey{}, "42")
+
+	// action.
+	insertErr := executor.Insert(ctx, s.sut, &Account{Username: "fr33r"})
+	saveErr := repository.Save(ctx, &Account{ID: 1, Username: "jon"})
+	s.Require().NoError(work.Add(&Account{Username: "ben"}, &LineItem{OrderID: 1, Line: 1, Quantity: 2}))
+	workErr := work.Save(ctx)
+
+	// assert.
+	s.Require().NoError(insertErr)
+	s.Require().NoError(saveErr)
+	s.Require().NoError(workErr)
+	s.NotEmpty(ids)
+	for _, id := range ids {
+		s.Equal("42", id)
+	}
+}
+
+func (s *HookTestSuite) TestExecutor_ReturningWithTrailingComment() {
+	// arrange.
+	executor, err := morph.NewExecutor(s.database(), morph.WithDialect("sqlite"), morph.WithReturning())
+	s.Require().NoError(err)
+	var query string
+	s.sut.AddHooks(morph.Hook{
+		AfterQuery: func(op *morph.Operation) error {
+			op.Query += " /*request_id='42'*/"
+			query = op.Query
+			return nil
+		},
+	})
+	account := Account{Username: "fr33r"}
+
+	// action.
+	err = executor.Insert(context.Background(), s.sut, &account)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal("INSERT INTO accounts (email, nickname, username) VALUES (?, ?, ?) RETURNING id; /*request_id='42'*/", query)
+	s.Equal(int64(2), account.ID)
+}
+
+// database opens an in-memory database containing the tables of the entities used to
+// test the hooks, which is closed once the test completes.
+func (s *HookTestSuite) database() *sql.DB {
+	db, err := sql.Open("sqlite", ":memory:")
+	s.Require().NoError(err)
+	db.SetMaxOpenConns(1)
+	s.T().Cleanup(func() { db.Close() })
+	for _, statement := range []string{
+		"CREATE TABLE accounts (id INTEGER PRIMARY KEY AUTOINCREMENT, username TEXT NOT NULL, email TEXT, nickname TEXT)",
+		"CREATE TABLE line_items (order_id INTEGER NOT NULL, line INTEGER NOT NULL, quantity INTEGER NOT NULL, PRIMARY KEY (order_id, line))",
+		"INSERT INTO accounts (id, username) VALUES (1, 'existing')",
+	} {
+		_, err := db.Exec(statement)
+		s.Require().NoError(err)
+	}
+	return db
+}
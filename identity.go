@@ -0,0 +1,143 @@
+package morph
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// identityMapKey is the key of the identity map within a context.
+type identityMapKey struct{}
+
+// identity identifies the row of an entity by its table and primary key values.
+type identity struct {
+	typeName string
+	table    string
+	key      string
+}
+
+// IdentityMap keeps a single instance of each entity loaded within a scope, such as a
+// request, identified by its table and primary key values.
+type IdentityMap struct {
+	mu       sync.Mutex
+	entities map[identity]any
+}
+
+// NewIdentityMap constructs an empty identity map.
+func NewIdentityMap() *IdentityMap {
+	return &IdentityMap{entities: make(map[identity]any)}
+}
+
+// ContextWithIdentityMap retrieves a copy of the provided context carrying the provided
+// identity map, which repositories and units of work using the context then consult.
+func ContextWithIdentityMap(ctx context.Context, m *IdentityMap) context.Context {
+	return context.WithValue(ctx, identityMapKey{}, m)
+}
+
+// IdentityMapFromContext retrieves the identity map carried by the provided context, if any.
+func IdentityMapFromContext(ctx context.Context) (*IdentityMap, bool) {
+	m, ok := ctx.Value(identityMapKey{}).(*IdentityMap)
+	return m, ok && m != nil
+}
+
+// Get retrieves the entity of the table with the provided key, which is either an entity,
+// the value of a single primary key column, or the values of the primary key columns
+// ordered by column name. Keys are matched by their formatted values, so 1 and int64(1)
+// identify the same row.
+func (m *IdentityMap) Get(t Table, key any) (any, bool, error) {
+	id, err := t.identity(key)
+	if err != nil {
+		return nil, false, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entity, ok := m.entities[id]
+	return entity, ok, nil
+}
+
+// Put keeps the provided entity of the table, unless an instance for the same row is
+// already kept, and retrieves the instance that is kept.
+func (m *IdentityMap) Put(t Table, entity any) (any, error) {
+	id, err := t.identity(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if existing, ok := m.entities[id]; ok {
+		return existing, nil
+	}
+	m.entities[id] = entity
+	return entity, nil
+}
+
+// refresh keeps the provided entity of the table like Put, except that when another instance
+// is already kept for the same row, the values of the provided entity are copied into it so
+// that the kept instance reflects the entity saved.
+func (m *IdentityMap) refresh(t Table, entity any) error {
+	kept, err := m.Put(t, entity)
+	if err != nil || kept == entity {
+		return err
+	}
+
+	dst, src := reflect.ValueOf(kept), reflect.ValueOf(entity)
+	if dst.Kind() == reflect.Ptr && src.Kind() == reflect.Ptr && dst.Type() == src.Type() {
+		dst.Elem().Set(src.Elem())
+		return nil
+	}
+	if err := m.Remove(t, entity); err != nil {
+		return err
+	}
+	_, err = m.Put(t, entity)
+	return err
+}
+
+// Remove no longer keeps the entity of the table with the provided key, which is
+// interpreted in the same way as Get.
+func (m *IdentityMap) Remove(t Table, key any) error {
+	id, err := t.identity(key)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.entities, id)
+	return nil
+}
+
+// Len retrieves the number of entities kept.
+func (m *IdentityMap) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.entities)
+}
+
+// Clear no longer keeps any entities.
+func (m *IdentityMap) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entities = make(map[identity]any)
+}
+
+// identity retrieves the identity of the row of the table with the provided key, which is
+// interpreted in the same way as keyValues. Pointer values are dereferenced.
+func (t *Table) identity(key any) (identity, error) {
+	values, err := t.keyValues(context.Background(), t.FindColumns(func(c Column) bool { return c.PrimaryKey() }), key)
+	if err != nil {
+		return identity{}, err
+	}
+
+	formatted := make([]string, len(values))
+	for idx, value := range values {
+		if v := reflect.ValueOf(value); v.Kind() == reflect.Ptr && !v.IsNil() {
+			value = v.Elem().Interface()
+		}
+		formatted[idx] = fmt.Sprintf("%v", value)
+	}
+	return identity{typeName: t.typeName, table: t.name, key: strings.Join(formatted, "\x00")}, nil
+}
@@ -0,0 +1,147 @@
+package morph_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/freerware/morph"
+	"github.com/stretchr/testify/suite"
+)
+
+type IdentityMapTestSuite struct {
+	suite.Suite
+
+	accounts morph.Table
+	items    morph.Table
+	sut      *morph.IdentityMap
+}
+
+func TestIdentityMapTestSuite(t *testing.T) {
+	suite.Run(t, new(IdentityMapTestSuite))
+}
+
+func (s *IdentityMapTestSuite) SetupTest() {
+	tables := morph.Must(executorTables.AsMetadataStrict())
+	s.accounts, s.items = tables[0], tables[1]
+	s.sut = morph.NewIdentityMap()
+}
+
+func (s *IdentityMapTestSuite) SetupSubTest() {
+	s.SetupTest()
+}
+
+func (s *IdentityMapTestSuite) TestGet() {
+	tests := []struct {
+		name  string
+		table morph.Table
+		key   any
+		found bool
+	}{
+		{name: "Value", table: s.accounts, key: 1, found: true},
+		{name: "ValueOfAnotherType", table: s.accounts, key: int64(1), found: true},
+		{name: "Entity", table: s.accounts, key: Account{ID: 1}, found: true},
+		{name: "CompositeKey", table: s.items, key: []any{2, 1}, found: true},
+		{name: "Missing", table: s.accounts, key: 2, found: false},
+		{name: "AnotherTable", table: s.items, key: []any{1, 1}, found: false},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// arrange.
+			account := &Account{ID: 1, Username: "fr33r"}
+			item := &LineItem{OrderID: 1, Line: 2}
+			morph.Must(s.sut.Put(s.accounts, account))
+			morph.Must(s.sut.Put(s.items, item))
+
+			// action.
+			entity, found, err := s.sut.Get(test.table, test.key)
+
+			// assert.
+			s.Require().NoError(err)
+			s.Equal(test.found, found)
+			if test.found {
+				s.Contains([]any{account, item}, entity)
+			}
+		})
+	}
+}
+
+func (s *IdentityMapTestSuite) TestGet_InvalidKey() {
+	// action.
+	_, _, err := s.sut.Get(s.items, 1)
+
+	// assert.
+	s.ErrorIs(err, morph.ErrInvalidKey)
+}
+
+func (s *IdentityMapTestSuite) TestGet_EntityOfAnotherType() {
+	// arrange.
+	morph.Must(s.sut.Put(s.accounts, &Account{ID: 1}))
+
+	// action.
+	_, found, err := s.sut.Get(s.accounts, &LineItem{OrderID: 1, Line: 1})
+
+	// assert.
+	s.ErrorIs(err, morph.ErrMismatchingTypeName)
+	s.False(found)
+}
+
+func (s *IdentityMapTestSuite) TestPut() {
+	// arrange.
+	first := &Account{ID: 1, Username: "first"}
+	second := &Account{ID: 1, Username: "second"}
+
+	// action.
+	firstKept, firstErr := s.sut.Put(s.accounts, first)
+	secondKept, secondErr := s.sut.Put(s.accounts, second)
+
+	// assert.
+	s.Require().NoError(firstErr)
+	s.Require().NoError(secondErr)
+	s.Same(first, firstKept)
+	s.Same(first, secondKept)
+	s.Equal(1, s.sut.Len())
+}
+
+func (s *IdentityMapTestSuite) TestRemove() {
+	// arrange.
+	_, err := s.sut.Put(s.accounts, &Account{ID: 1})
+	s.Require().NoError(err)
+	_, err = s.sut.Put(s.accounts, &Account{ID: 2})
+	s.Require().NoError(err)
+
+	// action.
+	err = s.sut.Remove(s.accounts, 1)
+
+	// assert.
+	s.Require().NoError(err)
+	_, found, _ := s.sut.Get(s.accounts, 1)
+	s.False(found)
+	s.Equal(1, s.sut.Len())
+}
+
+func (s *IdentityMapTestSuite) TestClear() {
+	// arrange.
+	_, err := s.sut.Put(s.accounts, &Account{ID: 1})
+	s.Require().NoError(err)
+
+	// action.
+	s.sut.Clear()
+
+	// assert.
+	s.Zero(s.sut.Len())
+}
+
+func (s *IdentityMapTestSuite) TestContext() {
+	// arrange.
+	ctx := context.Background()
+
+	// action.
+	_, missing := morph.IdentityMapFromContext(ctx)
+	m, found := morph.IdentityMapFromContext(morph.ContextWithIdentityMap(ctx, s.sut))
+
+	// assert.
+	s.False(missing)
+	s.True(found)
+	s.Same(s.sut, m)
+}
@@ -33,7 +33,7 @@ var (
 )
 
 func BenchmarkReflect_DefaultOptions(b *testing.B) {
-	for b.Loop() {
+	for i := 0; i < b.N; i++ {
 		_, err := morph.Reflect(millenniumFalcon)
 		if err != nil {
 			b.FailNow()
@@ -42,7 +42,7 @@ func BenchmarkReflect_DefaultOptions(b *testing.B) {
 }
 
 func BenchmarkReflect_WithTag(b *testing.B) {
-	for b.Loop() {
+	for i := 0; i < b.N; i++ {
 		_, err := morph.Reflect(millenniumFalcon, morph.WithTag("morph"))
 		if err != nil {
 			b.FailNow()
@@ -51,7 +51,7 @@ func BenchmarkReflect_WithTag(b *testing.B) {
 }
 
 func BenchmarkReflect_WithoutFields(b *testing.B) {
-	for b.Loop() {
+	for i := 0; i < b.N; i++ {
 		_, err := morph.Reflect(millenniumFalcon, morph.WithoutFields("Length", "LengthUnit", "Speed", "SpeedUnit"))
 		if err != nil {
 			b.FailNow()
@@ -60,7 +60,7 @@ func BenchmarkReflect_WithoutFields(b *testing.B) {
 }
 
 func BenchmarkReflect_WithoutMatchingFields(b *testing.B) {
-	for b.Loop() {
+	for i := 0; i < b.N; i++ {
 		_, err := morph.Reflect(millenniumFalcon, morph.WithoutMatchingFields(`^\w+Unit`))
 		if err != nil {
 			b.FailNow()
@@ -69,7 +69,7 @@ func BenchmarkReflect_WithoutMatchingFields(b *testing.B) {
 }
 
 func BenchmarkReflect_WithPrimaryKeyColumn(b *testing.B) {
-	for b.Loop() {
+	for i := 0; i < b.N; i++ {
 		_, err := morph.Reflect(millenniumFalcon, morph.WithPrimaryKeyColumn("ID"))
 		if err != nil {
 			b.FailNow()
@@ -78,7 +78,7 @@ func BenchmarkReflect_WithPrimaryKeyColumn(b *testing.B) {
 }
 
 func BenchmarkReflect_WithPrimaryKeyColumns(b *testing.B) {
-	for b.Loop() {
+	for i := 0; i < b.N; i++ {
 		_, err := morph.Reflect(millenniumFalcon, morph.WithPrimaryKeyColumns("ID"))
 		if err != nil {
 			b.FailNow()
@@ -87,7 +87,7 @@ func BenchmarkReflect_WithPrimaryKeyColumns(b *testing.B) {
 }
 
 func BenchmarkReflect_WithTableName(b *testing.B) {
-	for b.Loop() {
+	for i := 0; i < b.N; i++ {
 		_, err := morph.Reflect(millenniumFalcon, morph.WithTableName("RazorCrest"))
 		if err != nil {
 			b.FailNow()
@@ -101,7 +101,8 @@ func BenchmarkTableInsertQuery_DefaultOptions(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, err := table.InsertQuery()
 		if err != nil {
 			b.FailNow()
@@ -115,7 +116,8 @@ func BenchmarkTableInsertQuery_WithPlaceholder(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, err := table.InsertQuery(morph.WithPlaceholder("$", true))
 		if err != nil {
 			b.FailNow()
@@ -129,7 +131,8 @@ func BenchmarkTableInsertQuery_WithNamedParameters(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, err := table.InsertQuery(morph.WithNamedParameters())
 		if err != nil {
 			b.FailNow()
@@ -143,7 +146,8 @@ func BenchmarkTableUpdateQuery_DefaultOptions(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, err := table.UpdateQuery()
 		if err != nil {
 			b.FailNow()
@@ -157,7 +161,8 @@ func BenchmarkTableUpdateQuery_WithPlaceholder(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, err := table.UpdateQuery(morph.WithPlaceholder("$", true))
 		if err != nil {
 			b.FailNow()
@@ -171,7 +176,8 @@ func BenchmarkTableUpdateQuery_WithNamedParameters(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, err := table.UpdateQuery(morph.WithNamedParameters())
 		if err != nil {
 			b.FailNow()
@@ -185,7 +191,8 @@ func BenchmarkTableUpdateQuery_WithoutEmptyValues(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, err := table.UpdateQuery(morph.WithoutEmptyValues(millenniumFalcon))
 		if err != nil {
 			b.FailNow()
@@ -199,7 +206,8 @@ func BenchmarkTableDeleteQuery_DefaultOptions(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, err := table.DeleteQuery()
 		if err != nil {
 			b.FailNow()
@@ -213,7 +221,8 @@ func BenchmarkTableDeleteQuery_WithPlaceholder(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, err := table.DeleteQuery(morph.WithPlaceholder("$", true))
 		if err != nil {
 			b.FailNow()
@@ -227,7 +236,8 @@ func BenchmarkTableDeleteQuery_WithNamedParameters(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, err := table.DeleteQuery(morph.WithNamedParameters())
 		if err != nil {
 			b.FailNow()
@@ -241,7 +251,8 @@ func BenchmarkTableSelectQuery_DefaultOptions(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, err := table.SelectQuery()
 		if err != nil {
 			b.FailNow()
@@ -255,7 +266,8 @@ func BenchmarkTableSelectQuery_WithPlaceholder(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, err := table.SelectQuery(morph.WithPlaceholder("$", true))
 		if err != nil {
 			b.FailNow()
@@ -269,7 +281,8 @@ func BenchmarkTableSelectQuery_WithNamedParameters(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, err := table.SelectQuery(morph.WithNamedParameters())
 		if err != nil {
 			b.FailNow()
@@ -283,7 +296,8 @@ func BenchmarkTableMustInsertQuery_DefaultOptions(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		table.MustInsertQuery()
 	}
 }
@@ -294,7 +308,8 @@ func BenchmarkTableMustInsertQuery_WithPlaceholder(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		table.MustInsertQuery(morph.WithPlaceholder("$", true))
 	}
 }
@@ -305,7 +320,8 @@ func BenchmarkTableMustInsertQuery_WithNamedParameters(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		table.MustInsertQuery(morph.WithNamedParameters())
 	}
 }
@@ -316,7 +332,8 @@ func BenchmarkTableMustUpdateQuery_DefaultOptions(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		table.MustUpdateQuery()
 	}
 }
@@ -327,7 +344,8 @@ func BenchmarkTableMustUpdateQuery_WithPlaceholder(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		table.MustUpdateQuery(morph.WithPlaceholder("$", true))
 	}
 }
@@ -338,7 +356,8 @@ func BenchmarkTableMustUpdateQuery_WithNamedParameters(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		table.MustUpdateQuery(morph.WithNamedParameters())
 	}
 }
@@ -349,7 +368,8 @@ func BenchmarkTableMustUpdateQuery_WithoutEmptyValues(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		table.MustUpdateQuery(morph.WithoutEmptyValues(millenniumFalcon))
 	}
 }
@@ -360,7 +380,8 @@ func BenchmarkTableMustDeleteQuery_DefaultOptions(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		table.MustDeleteQuery()
 	}
 }
@@ -371,7 +392,8 @@ func BenchmarkTableMustDeleteQuery_WithPlaceholder(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		table.MustDeleteQuery(morph.WithPlaceholder("$", true))
 	}
 }
@@ -382,7 +404,8 @@ func BenchmarkTableMustDeleteQuery_WithNamedParameters(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		table.MustDeleteQuery(morph.WithNamedParameters())
 	}
 }
@@ -393,7 +416,8 @@ func BenchmarkTableMustSelectQuery_DefaultOptions(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		table.MustSelectQuery()
 	}
 }
@@ -404,7 +428,8 @@ func BenchmarkTableMustSelectQuery_WithPlaceholder(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		table.MustSelectQuery(morph.WithPlaceholder("$", true))
 	}
 }
@@ -415,7 +440,8 @@ func BenchmarkTableMustSelectQuery_WithNamedParameters(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		table.MustSelectQuery(morph.WithNamedParameters())
 	}
 }
@@ -426,7 +452,8 @@ func BenchmarkTableInsertQueryWithArgs_DefaultOptions(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, _, err := table.InsertQueryWithArgs(millenniumFalcon)
 		if err != nil {
 			b.FailNow()
@@ -440,7 +467,8 @@ func BenchmarkTableInsertQueryWithArgs_WithPlaceholder(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, _, err := table.InsertQueryWithArgs(millenniumFalcon, morph.WithPlaceholder("$", true))
 		if err != nil {
 			b.FailNow()
@@ -454,7 +482,8 @@ func BenchmarkTableInsertQueryWithArgs_WithNamedParameters(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, _, err := table.InsertQueryWithArgs(millenniumFalcon, morph.WithNamedParameters())
 		if err != nil {
 			b.FailNow()
@@ -468,7 +497,8 @@ func BenchmarkTableUpdateQueryWithArgs_DefaultOptions(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, _, err := table.UpdateQueryWithArgs(millenniumFalcon)
 		if err != nil {
 			b.FailNow()
@@ -482,7 +512,8 @@ func BenchmarkTableUpdateQueryWithArgs_WithPlaceholder(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, _, err := table.UpdateQueryWithArgs(millenniumFalcon, morph.WithPlaceholder("$", true))
 		if err != nil {
 			b.FailNow()
@@ -496,7 +527,8 @@ func BenchmarkTableUpdateQueryWithArgs_WithNamedParameters(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, _, err := table.UpdateQueryWithArgs(millenniumFalcon, morph.WithNamedParameters())
 		if err != nil {
 			b.FailNow()
@@ -510,7 +542,8 @@ func BenchmarkTableUpdateQueryWithArgs_WithoutEmptyValues(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, _, err := table.UpdateQueryWithArgs(millenniumFalcon, morph.WithoutEmptyValues(millenniumFalcon))
 		if err != nil {
 			b.FailNow()
@@ -524,7 +557,8 @@ func BenchmarkTableDeleteQueryWithArgs_DefaultOptions(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, _, err := table.DeleteQueryWithArgs(millenniumFalcon)
 		if err != nil {
 			b.FailNow()
@@ -538,7 +572,8 @@ func BenchmarkTableDeleteQueryWithArgs_WithPlaceholder(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, _, err := table.DeleteQueryWithArgs(millenniumFalcon, morph.WithPlaceholder("$", true))
 		if err != nil {
 			b.FailNow()
@@ -552,7 +587,8 @@ func BenchmarkTableDeleteQueryWithArgs_WithNamedParameters(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, _, err := table.DeleteQueryWithArgs(millenniumFalcon, morph.WithNamedParameters())
 		if err != nil {
 			b.FailNow()
@@ -566,7 +602,8 @@ func BenchmarkTableSelectQueryWithArgs_DefaultOptions(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, _, err := table.SelectQueryWithArgs(millenniumFalcon)
 		if err != nil {
 			b.FailNow()
@@ -580,7 +617,8 @@ func BenchmarkTableSelectQueryWithArgs_WithPlaceholder(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, _, err := table.SelectQueryWithArgs(millenniumFalcon, morph.WithPlaceholder("$", true))
 		if err != nil {
 			b.FailNow()
@@ -594,7 +632,8 @@ func BenchmarkTableSelectQueryWithArgs_WithNamedParameters(b *testing.B) {
 		b.FailNow()
 	}
 
-	for b.Loop() {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_, _, err := table.SelectQueryWithArgs(millenniumFalcon, morph.WithNamedParameters())
 		if err != nil {
 			b.FailNow()
@@ -0,0 +1,121 @@
+// Package schema generates JSON Schemas from Go types.
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Draft is the JSON Schema draft the generated schemas conform to.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Generate generates the JSON Schema for the provided struct type. Property names are taken
+// from the json struct tags, and constraints from the jsonschema struct tags, which accept
+// "required" and "enum=a|b". String properties with an enum also accept values referencing
+// environment variables, such as ${NAME}, since loaders may interpolate them after validation.
+// Nested struct types are defined within $defs and referenced by their type name.
+func Generate(t reflect.Type, title string) ([]byte, error) {
+	defs := make(map[string]any)
+	root, err := object(t, defs)
+	if err != nil {
+		return nil, err
+	}
+	root["$schema"] = Draft
+	root["title"] = title
+	if len(defs) > 0 {
+		root["$defs"] = defs
+	}
+
+	data, err := json.MarshalIndent(root, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// object generates the schema for the provided struct type.
+func object(t reflect.Type, defs map[string]any) (map[string]any, error) {
+	properties := make(map[string]any)
+	required := []string{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		s, err := value(f.Type, defs)
+		if err != nil {
+			return nil, fmt.Errorf("schema: field %s.%s: %w", t.Name(), f.Name, err)
+		}
+
+		for _, opt := range strings.Split(f.Tag.Get("jsonschema"), ",") {
+			switch key, val, _ := strings.Cut(opt, "="); key {
+			case "":
+			case "required":
+				required = append(required, name)
+			case "enum":
+				s["anyOf"] = []any{
+					map[string]any{"enum": strings.Split(val, "|")},
+					map[string]any{"pattern": `\$\{`},
+				}
+			default:
+				return nil, fmt.Errorf("schema: field %s.%s: unknown option %q", t.Name(), f.Name, key)
+			}
+		}
+		properties[name] = s
+	}
+
+	s := map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		s["required"] = required
+	}
+	return s, nil
+}
+
+// value generates the schema for a value of the provided type.
+func value(t reflect.Type, defs map[string]any) (map[string]any, error) {
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]any{"type": "string"}, nil
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}, nil
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}, nil
+	case reflect.Pointer:
+		return value(t.Elem(), defs)
+	case reflect.Slice, reflect.Array:
+		items, err := value(t.Elem(), defs)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"type": "array", "items": items}, nil
+	case reflect.Struct:
+		if _, ok := defs[t.Name()]; !ok {
+			defs[t.Name()] = nil
+			def, err := object(t, defs)
+			if err != nil {
+				return nil, err
+			}
+			defs[t.Name()] = def
+		}
+		return map[string]any{"$ref": "#/$defs/" + t.Name()}, nil
+	}
+	return nil, fmt.Errorf("unsupported kind %s", t.Kind())
+}
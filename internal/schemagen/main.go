@@ -0,0 +1,25 @@
+// Command schemagen generates the JSON Schema for the morph configuration format.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"reflect"
+
+	"github.com/freerware/morph"
+	"github.com/freerware/morph/internal/schema"
+)
+
+func main() {
+	out := flag.String("o", "schema.json", "the file to write the schema to")
+	flag.Parse()
+
+	data, err := schema.Generate(reflect.TypeOf(morph.Configuration{}), morph.SchemaTitle)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, data, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
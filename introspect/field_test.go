@@ -0,0 +1,77 @@
+package introspect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FieldTestSuite struct {
+	suite.Suite
+}
+
+func TestFieldTestSuite(t *testing.T) {
+	suite.Run(t, new(FieldTestSuite))
+}
+
+func (s *FieldTestSuite) TestFieldType() {
+	tests := []struct {
+		dialect  string
+		dataType string
+		nullable bool
+		expected string
+	}{
+		{dialect: "postgres", dataType: "integer", expected: "int32"},
+		{dialect: "postgres", dataType: "bigint", nullable: true, expected: "*int64"},
+		{dialect: "postgres", dataType: "smallint", expected: "int16"},
+		{dialect: "postgres", dataType: "boolean", expected: "bool"},
+		{dialect: "postgres", dataType: "character varying", nullable: true, expected: "*string"},
+		{dialect: "postgres", dataType: "text", expected: "string"},
+		{dialect: "postgres", dataType: "uuid", expected: "string"},
+		{dialect: "postgres", dataType: "numeric", expected: "string"},
+		{dialect: "postgres", dataType: "double precision", expected: "float64"},
+		{dialect: "postgres", dataType: "real", expected: "float32"},
+		{dialect: "postgres", dataType: "timestamp with time zone", nullable: true, expected: "*time.Time"},
+		{dialect: "postgres", dataType: "date", expected: "time.Time"},
+		{dialect: "postgres", dataType: "bytea", nullable: true, expected: "[]byte"},
+		{dialect: "postgres", dataType: "jsonb", expected: "[]byte"},
+		{dialect: "postgres", dataType: "USER-DEFINED", expected: "string"},
+		{dialect: "mysql", dataType: "tinyint(1)", expected: "bool"},
+		{dialect: "mysql", dataType: "tinyint(4)", expected: "int8"},
+		{dialect: "mysql", dataType: "int(10) unsigned", expected: "int32"},
+		{dialect: "mysql", dataType: "bigint(20)", expected: "int64"},
+		{dialect: "mysql", dataType: "varchar(255)", nullable: true, expected: "*string"},
+		{dialect: "mysql", dataType: "datetime", expected: "time.Time"},
+		{dialect: "mysql", dataType: "decimal(10,2)", expected: "string"},
+		{dialect: "mysql", dataType: "enum('a','b')", expected: "string"},
+		{dialect: "mysql", dataType: "varbinary(16)", expected: "[]byte"},
+		{dialect: "mysql", dataType: "double", expected: "float64"},
+		{dialect: "sqlite", dataType: "INTEGER", expected: "int64"},
+		{dialect: "sqlite", dataType: "UNSIGNED BIG INT", expected: "int64"},
+		{dialect: "sqlite", dataType: "", expected: "any"},
+	}
+
+	for _, test := range tests {
+		s.Run(test.dialect+"_"+test.dataType, func() {
+			s.Equal(test.expected, fieldType(test.dialect, test.dataType, test.nullable))
+		})
+	}
+}
+
+func (s *FieldTestSuite) TestFieldName() {
+	tests := map[string]string{
+		"id":         "ID",
+		"user_id":    "UserID",
+		"userId":     "UserID",
+		"api_url":    "APIURL",
+		"created_at": "CreatedAt",
+		"Name":       "Name",
+		"identity":   "Identity",
+	}
+
+	for column, expected := range tests {
+		s.Run(column, func() {
+			s.Equal(expected, fieldName(column))
+		})
+	}
+}
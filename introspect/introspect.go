@@ -0,0 +1,398 @@
+// Package introspect reverse engineers morph configurations from the schema of a live
+// database, so that mappings for existing tables do not need to be written by hand.
+package introspect
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/freerware/morph"
+	"github.com/gertd/go-pluralize"
+	"github.com/iancoleman/strcase"
+)
+
+// Defines the various errors that can occur when introspecting a database.
+var (
+	// ErrUnsupportedDialect represents an error encountered when introspecting a database
+	// of a dialect that does not have a known schema catalog.
+	ErrUnsupportedDialect = errors.New("introspect: unsupported dialect")
+
+	// ErrNoTables represents an error encountered when the database has no tables to introspect.
+	ErrNoTables = errors.New("introspect: no tables found")
+
+	// ErrMissingTables represents an error encountered when tables requested using WithTables
+	// are not within the database.
+	ErrMissingTables = errors.New("introspect: tables not found")
+
+	// ErrMissingPackage represents an error encountered when introspecting without a package
+	// to qualify the inferred type names, which morph compares with the names of entity types.
+	ErrMissingPackage = errors.New("introspect: must have a package to qualify type names")
+)
+
+// Option is a function that configures the introspection options.
+type Option func(*Options)
+
+// Options represents the options used when introspecting a database.
+type Options struct {
+	// Schema is the schema, or database for MySQL, containing the tables. It defaults
+	// to the current schema of the connection, and is ignored for SQLite.
+	Schema string
+
+	// Tables are the names of the tables to introspect. All tables are introspected when empty.
+	Tables []string
+
+	// Package is the name of the Go package that qualifies the inferred type names,
+	// such as "models" for "models.User". It is required, since the type names must
+	// match those of the entity types for the tables to map them.
+	Package string
+
+	// AliasLength is the length of the inferred table aliases.
+	AliasLength int
+}
+
+// WithSchema introspects the tables within the provided schema, or database for MySQL.
+func WithSchema(schema string) Option {
+	return func(o *Options) {
+		o.Schema = schema
+	}
+}
+
+// WithTables only introspects the tables with the provided names, failing with
+// ErrMissingTables when any of them are not within the database.
+func WithTables(tables ...string) Option {
+	return func(o *Options) {
+		o.Tables = append([]string{}, tables...)
+	}
+}
+
+// WithPackage qualifies the inferred type names with the provided Go package name, which
+// is the name of the package declaring the entity types rather than its import path.
+func WithPackage(pkg string) Option {
+	return func(o *Options) {
+		o.Package = pkg
+	}
+}
+
+// WithAliasLength infers table aliases of the provided length.
+func WithAliasLength(length int) Option {
+	return func(o *Options) {
+		o.AliasLength = length
+	}
+}
+
+// column represents a column read from the schema catalog of a database.
+type column struct {
+	table      string
+	name       string
+	dataType   string
+	nullable   bool
+	primaryKey bool
+}
+
+// catalog reads the columns of the tables within a schema, in table and ordinal order.
+type catalog func(ctx context.Context, db *sql.DB, schema string) ([]column, error)
+
+// catalogs are the schema catalogs of each supported dialect, keyed by the dialect name
+// used within morph.Dialects.
+var catalogs = map[string]catalog{
+	"postgres": informationSchema(postgresSchemaSQL, postgresColumnsSQL, postgresKeysSQL),
+	"mysql":    informationSchema(mysqlSchemaSQL, mysqlColumnsSQL, mysqlKeysSQL),
+	"sqlite":   sqliteMaster,
+}
+
+// Introspect reads the tables of the provided database, of the provided dialect, and produces
+// the configuration mapping them. The type and field names are inferred from the table and
+// column names, and the field types from the column types, with pointers for nullable columns.
+// The package qualifying the type names must be provided using WithPackage.
+func Introspect(ctx context.Context, db *sql.DB, dialect string, options ...Option) (morph.Configuration, error) {
+	o := Options{AliasLength: morph.DefaultTableAliasLength}
+	for _, opt := range options {
+		opt(&o)
+	}
+	if o.Package == "" {
+		return morph.Configuration{}, ErrMissingPackage
+	}
+
+	read, ok := catalogs[dialect]
+	if !ok {
+		return morph.Configuration{}, fmt.Errorf("%w %q", ErrUnsupportedDialect, dialect)
+	}
+	columns, err := read(ctx, db, o.Schema)
+	if err != nil {
+		return morph.Configuration{}, err
+	}
+
+	wanted := make(map[string]bool, len(o.Tables))
+	for _, t := range o.Tables {
+		wanted[t] = true
+	}
+
+	var c morph.Configuration
+	aliases := make(map[string]bool)
+	byName := make(map[string]int)
+	for _, col := range columns {
+		if len(wanted) > 0 && !wanted[col.table] {
+			continue
+		}
+
+		i, ok := byName[col.table]
+		if !ok {
+			i = len(c.Tables)
+			byName[col.table] = i
+			c.Tables = append(c.Tables, morph.TableConfiguration{
+				TypeName: typeName(o.Package, col.table),
+				Name:     col.table,
+				Alias:    alias(col.table, o.AliasLength, aliases),
+			})
+		}
+
+		c.Tables[i].Columns = append(c.Tables[i].Columns, morph.ColumnConfiguration{
+			Name:          col.name,
+			Field:         fieldName(col.name),
+			FieldType:     fieldType(dialect, col.dataType, col.nullable && !col.primaryKey),
+			FieldStrategy: morph.FieldStrategyStructField,
+			PrimaryKey:    col.primaryKey,
+		})
+	}
+
+	var missing []string
+	for _, t := range o.Tables {
+		if _, ok := byName[t]; !ok {
+			missing = append(missing, t)
+		}
+	}
+	if len(missing) > 0 {
+		return morph.Configuration{}, fmt.Errorf("%w: %s", ErrMissingTables, strings.Join(missing, ", "))
+	}
+
+	if len(c.Tables) == 0 {
+		return morph.Configuration{}, ErrNoTables
+	}
+	return c, nil
+}
+
+// informationSchema reads the columns from the information_schema views using the provided
+// queries, which retrieve the current schema, the columns, and the primary key columns.
+func informationSchema(schemaSQL, columnsSQL, keysSQL string) catalog {
+	return func(ctx context.Context, db *sql.DB, schema string) ([]column, error) {
+		if schema == "" {
+			if err := db.QueryRowContext(ctx, schemaSQL).Scan(&schema); err != nil {
+				return nil, err
+			}
+		}
+
+		keys := make(map[[2]string]bool)
+		rows, err := db.QueryContext(ctx, keysSQL, schema)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var key [2]string
+			if err := rows.Scan(&key[0], &key[1]); err != nil {
+				return nil, err
+			}
+			keys[key] = true
+		}
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+
+		rows, err = db.QueryContext(ctx, columnsSQL, schema)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		var columns []column
+		for rows.Next() {
+			var col column
+			var nullable string
+			if err := rows.Scan(&col.table, &col.name, &col.dataType, &nullable); err != nil {
+				return nil, err
+			}
+			col.nullable = strings.EqualFold(nullable, "YES")
+			col.primaryKey = keys[[2]string{col.table, col.name}]
+			columns = append(columns, col)
+		}
+		return columns, rows.Err()
+	}
+}
+
+// sqliteMaster reads the columns from the sqlite_master table and the table_info pragma.
+func sqliteMaster(ctx context.Context, db *sql.DB, _ string) ([]column, error) {
+	rows, err := db.QueryContext(ctx, sqliteTablesSQL)
+	if err != nil {
+		return nil, err
+	}
+	var tables []string
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var columns []column
+	for _, table := range tables {
+		rows, err := db.QueryContext(ctx, sqliteColumnsSQL, table)
+		if err != nil {
+			return nil, err
+		}
+		for rows.Next() {
+			col := column{table: table}
+			var notNull bool
+			var pk int
+			if err := rows.Scan(&col.name, &col.dataType, &notNull, &pk); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			col.nullable, col.primaryKey = !notNull, pk > 0
+			columns = append(columns, col)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return columns, nil
+}
+
+// typeName infers the name of the type mapped to the provided table, qualified by the
+// provided package name.
+func typeName(pkg, table string) string {
+	return pkg + "." + strcase.ToCamel(pluralize.NewClient().Singular(table))
+}
+
+// alias infers a unique alias of the provided length for the provided table, recording it
+// within the provided aliases already in use.
+func alias(table string, length int, used map[string]bool) string {
+	prefix := strings.ToUpper(strcase.ToSnake(table))
+	prefix = strings.ReplaceAll(prefix, "_", "")
+	if length > 0 && length < len(prefix) {
+		prefix = prefix[:length]
+	}
+	name := prefix
+	for i := 2; used[name]; i++ {
+		name = fmt.Sprintf("%s%d", prefix, i)
+	}
+	used[name] = true
+	return name
+}
+
+// initialisms are the words that are capitalized entirely within inferred field names,
+// following the Go naming conventions.
+var initialisms = map[string]bool{
+	"api": true, "ascii": true, "cpu": true, "css": true, "dns": true, "html": true,
+	"http": true, "https": true, "id": true, "ip": true, "json": true, "sql": true,
+	"ssh": true, "tcp": true, "tls": true, "ttl": true, "udp": true, "ui": true,
+	"uid": true, "uri": true, "url": true, "utf8": true, "uuid": true, "xml": true,
+}
+
+// fieldName infers the name of the field mapped to the provided column.
+func fieldName(column string) string {
+	var b strings.Builder
+	for _, word := range strings.Split(strcase.ToSnake(column), "_") {
+		if initialisms[word] {
+			b.WriteString(strings.ToUpper(word))
+		} else {
+			b.WriteString(strcase.ToCamel(word))
+		}
+	}
+	return b.String()
+}
+
+// fieldType infers the Go type of the field mapped to a column of the provided dialect with
+// the provided data type, which is a pointer when the column is nullable.
+func fieldType(dialect, dataType string, nullable bool) string {
+	t := strings.ToLower(strings.TrimSpace(dataType))
+	base, _, _ := strings.Cut(t, "(")
+	base = strings.TrimSpace(strings.TrimSuffix(base, " unsigned"))
+
+	var goType string
+	switch {
+	case dialect == "sqlite" && strings.Contains(base, "int"):
+		// SQLite stores every integer in up to 8 bytes, and assigns integer affinity
+		// to any type containing "INT".
+		goType = "int64"
+	case t == "tinyint(1)" || base == "bool" || base == "boolean" || base == "bit":
+		goType = "bool"
+	case base == "tinyint":
+		goType = "int8"
+	case base == "smallint" || base == "int2" || base == "smallserial":
+		goType = "int16"
+	case base == "integer" || base == "int" || base == "int4" || base == "mediumint" || base == "serial":
+		goType = "int32"
+	case base == "bigint" || base == "int8" || base == "bigserial":
+		goType = "int64"
+	case base == "real" || base == "float4":
+		goType = "float32"
+	case base == "double" || base == "double precision" || base == "float" || base == "float8":
+		goType = "float64"
+	case base == "bytea" || strings.Contains(base, "blob") || strings.Contains(base, "binary") || base == "json" || base == "jsonb":
+		return "[]byte"
+	case strings.HasPrefix(base, "timestamp") || strings.HasPrefix(base, "time") || base == "date" || base == "datetime":
+		goType = "time.Time"
+	case strings.Contains(base, "char") || strings.Contains(base, "text") || strings.Contains(base, "clob") ||
+		base == "uuid" || base == "enum" || base == "set" || base == "numeric" || base == "decimal" || base == "citext":
+		goType = "string"
+	case base == "":
+		return "any"
+	default:
+		goType = "string"
+	}
+
+	if nullable {
+		return "*" + goType
+	}
+	return goType
+}
+
+const (
+	// postgresSchemaSQL retrieves the current schema of a Postgres connection.
+	postgresSchemaSQL = `SELECT current_schema()`
+
+	// postgresColumnsSQL retrieves the columns of the base tables within a Postgres schema.
+	postgresColumnsSQL = `SELECT c.table_name, c.column_name, c.data_type, c.is_nullable
+FROM information_schema.columns AS c
+JOIN information_schema.tables AS t ON t.table_schema = c.table_schema AND t.table_name = c.table_name
+WHERE c.table_schema = $1 AND t.table_type = 'BASE TABLE'
+ORDER BY c.table_name, c.ordinal_position`
+
+	// postgresKeysSQL retrieves the primary key columns of the tables within a Postgres schema.
+	postgresKeysSQL = `SELECT k.table_name, k.column_name
+FROM information_schema.table_constraints AS tc
+JOIN information_schema.key_column_usage AS k ON k.constraint_schema = tc.constraint_schema AND k.constraint_name = tc.constraint_name
+WHERE tc.table_schema = $1 AND tc.constraint_type = 'PRIMARY KEY'`
+
+	// mysqlSchemaSQL retrieves the current database of a MySQL connection.
+	mysqlSchemaSQL = `SELECT DATABASE()`
+
+	// mysqlColumnsSQL retrieves the columns of the base tables within a MySQL database. The
+	// column type is used rather than the data type, so that tinyint(1) maps to a bool.
+	mysqlColumnsSQL = `SELECT c.table_name, c.column_name, c.column_type, c.is_nullable
+FROM information_schema.columns AS c
+JOIN information_schema.tables AS t ON t.table_schema = c.table_schema AND t.table_name = c.table_name
+WHERE c.table_schema = ? AND t.table_type = 'BASE TABLE'
+ORDER BY c.table_name, c.ordinal_position`
+
+	// mysqlKeysSQL retrieves the primary key columns of the tables within a MySQL database.
+	mysqlKeysSQL = `SELECT k.table_name, k.column_name
+FROM information_schema.key_column_usage AS k
+WHERE k.table_schema = ? AND k.constraint_name = 'PRIMARY'`
+
+	// sqliteTablesSQL retrieves the names of the tables within a SQLite database.
+	sqliteTablesSQL = `SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' ORDER BY name`
+
+	// sqliteColumnsSQL retrieves the columns of a SQLite table in ordinal order.
+	sqliteColumnsSQL = `SELECT name, type, "notnull", pk FROM pragma_table_info(?) ORDER BY cid`
+)
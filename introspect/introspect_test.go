@@ -0,0 +1,211 @@
+package introspect_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/freerware/morph"
+	"github.com/freerware/morph/introspect"
+	"github.com/stretchr/testify/suite"
+	_ "modernc.org/sqlite"
+)
+
+// User is the entity mapped by the introspected users table.
+type User struct {
+	ID        int64
+	Username  string
+	Email     *string
+	CreatedAt time.Time
+	Avatar    []byte
+	Score     *float32
+	Active    bool
+	Balance   *string
+}
+
+type IntrospectTestSuite struct {
+	suite.Suite
+
+	db  *sql.DB
+	ctx context.Context
+}
+
+func TestIntrospectTestSuite(t *testing.T) {
+	suite.Run(t, new(IntrospectTestSuite))
+}
+
+func (s *IntrospectTestSuite) SetupTest() {
+	var err error
+	s.ctx = context.Background()
+	s.db, err = sql.Open("sqlite", ":memory:")
+	s.Require().NoError(err)
+	s.db.SetMaxOpenConns(1)
+
+	for _, statement := range []string{
+		`CREATE TABLE users (
+			id INTEGER PRIMARY KEY,
+			username TEXT NOT NULL,
+			email VARCHAR(255),
+			created_at DATETIME NOT NULL,
+			avatar BLOB,
+			score REAL,
+			active BOOLEAN NOT NULL,
+			balance NUMERIC(10, 2)
+		)`,
+		`CREATE TABLE order_items (
+			order_id INTEGER NOT NULL,
+			line_item INTEGER NOT NULL,
+			api_url TEXT,
+			PRIMARY KEY (order_id, line_item)
+		)`,
+		`CREATE TABLE user_roles (
+			user_id BIGINT NOT NULL PRIMARY KEY,
+			role TEXT NOT NULL
+		)`,
+		`CREATE VIEW active_users AS SELECT id, username FROM users WHERE active`,
+	} {
+		_, err := s.db.Exec(statement)
+		s.Require().NoError(err)
+	}
+}
+
+func (s *IntrospectTestSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *IntrospectTestSuite) TestIntrospect() {
+	// action.
+	c, err := introspect.Introspect(s.ctx, s.db, "sqlite", introspect.WithPackage("models"))
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal([]morph.TableConfiguration{
+		{
+			TypeName: "models.OrderItem",
+			Name:     "order_items",
+			Alias:    "O",
+			Columns: []morph.ColumnConfiguration{
+				{Name: "order_id", Field: "OrderID", FieldType: "int64", FieldStrategy: morph.FieldStrategyStructField, PrimaryKey: true},
+				{Name: "line_item", Field: "LineItem", FieldType: "int64", FieldStrategy: morph.FieldStrategyStructField, PrimaryKey: true},
+				{Name: "api_url", Field: "APIURL", FieldType: "*string", FieldStrategy: morph.FieldStrategyStructField},
+			},
+		},
+		{
+			TypeName: "models.UserRole",
+			Name:     "user_roles",
+			Alias:    "U",
+			Columns: []morph.ColumnConfiguration{
+				{Name: "user_id", Field: "UserID", FieldType: "int64", FieldStrategy: morph.FieldStrategyStructField, PrimaryKey: true},
+				{Name: "role", Field: "Role", FieldType: "string", FieldStrategy: morph.FieldStrategyStructField},
+			},
+		},
+		{
+			TypeName: "models.User",
+			Name:     "users",
+			Alias:    "U2",
+			Columns: []morph.ColumnConfiguration{
+				{Name: "id", Field: "ID", FieldType: "int64", FieldStrategy: morph.FieldStrategyStructField, PrimaryKey: true},
+				{Name: "username", Field: "Username", FieldType: "string", FieldStrategy: morph.FieldStrategyStructField},
+				{Name: "email", Field: "Email", FieldType: "*string", FieldStrategy: morph.FieldStrategyStructField},
+				{Name: "created_at", Field: "CreatedAt", FieldType: "time.Time", FieldStrategy: morph.FieldStrategyStructField},
+				{Name: "avatar", Field: "Avatar", FieldType: "[]byte", FieldStrategy: morph.FieldStrategyStructField},
+				{Name: "score", Field: "Score", FieldType: "*float32", FieldStrategy: morph.FieldStrategyStructField},
+				{Name: "active", Field: "Active", FieldType: "bool", FieldStrategy: morph.FieldStrategyStructField},
+				{Name: "balance", Field: "Balance", FieldType: "*string", FieldStrategy: morph.FieldStrategyStructField},
+			},
+		},
+	}, c.Tables)
+	s.NoError(c.Validate())
+	_, err = c.AsMetadataStrict()
+	s.NoError(err)
+}
+
+func (s *IntrospectTestSuite) TestIntrospect_Options() {
+	// action.
+	c, err := introspect.Introspect(s.ctx, s.db, "sqlite", introspect.WithPackage("models"), introspect.WithTables("users"), introspect.WithAliasLength(3))
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().Len(c.Tables, 1)
+	s.Equal("models.User", c.Tables[0].TypeName)
+	s.Equal("users", c.Tables[0].Name)
+	s.Equal("USE", c.Tables[0].Alias)
+	s.Len(c.Tables[0].Columns, 8)
+}
+
+func (s *IntrospectTestSuite) TestIntrospect_Repository() {
+	// arrange.
+	createdAt := time.Date(2024, 5, 4, 0, 0, 0, 0, time.UTC)
+	_, err := s.db.Exec("INSERT INTO users (id, username, created_at, active) VALUES (1, 'grogu', ?, TRUE)", createdAt)
+	s.Require().NoError(err)
+	c, err := introspect.Introspect(s.ctx, s.db, "sqlite", introspect.WithPackage("introspect_test"), introspect.WithTables("users"))
+	s.Require().NoError(err)
+	tables, err := c.AsMetadataStrict()
+	s.Require().NoError(err)
+	executor, err := morph.NewExecutor(s.db, morph.WithDialect("sqlite"))
+	s.Require().NoError(err)
+
+	// action.
+	repository, err := morph.NewRepository[User](executor, tables[0])
+
+	// assert.
+	s.Require().NoError(err)
+	user, err := repository.Find(s.ctx, 1)
+	s.Require().NoError(err)
+	s.Equal("grogu", user.Username)
+	s.True(createdAt.Equal(user.CreatedAt))
+	s.True(user.Active)
+	s.Nil(user.Email)
+}
+
+func (s *IntrospectTestSuite) TestIntrospect_MissingPackage() {
+	// action.
+	_, err := introspect.Introspect(s.ctx, s.db, "sqlite")
+
+	// assert.
+	s.ErrorIs(err, introspect.ErrMissingPackage)
+}
+
+func (s *IntrospectTestSuite) TestIntrospect_UnsupportedDialect() {
+	// action.
+	_, err := introspect.Introspect(s.ctx, s.db, "oracle", introspect.WithPackage("models"))
+
+	// assert.
+	s.ErrorIs(err, introspect.ErrUnsupportedDialect)
+	s.EqualError(err, `introspect: unsupported dialect "oracle"`)
+}
+
+func (s *IntrospectTestSuite) TestIntrospect_NoTables() {
+	// arrange.
+	db, err := sql.Open("sqlite", ":memory:")
+	s.Require().NoError(err)
+	defer db.Close()
+
+	// action.
+	_, err = introspect.Introspect(s.ctx, db, "sqlite", introspect.WithPackage("models"))
+
+	// assert.
+	s.ErrorIs(err, introspect.ErrNoTables)
+}
+
+func (s *IntrospectTestSuite) TestIntrospect_MissingTables() {
+	// action.
+	_, err := introspect.Introspect(s.ctx, s.db, "sqlite", introspect.WithPackage("models"), introspect.WithTables("users", "missing", "absent"))
+
+	// assert.
+	s.ErrorIs(err, introspect.ErrMissingTables)
+	s.EqualError(err, "introspect: tables not found: missing, absent")
+}
+
+func (s *IntrospectTestSuite) TestIntrospect_Canceled() {
+	// arrange.
+	ctx, cancel := context.WithCancel(s.ctx)
+	cancel()
+
+	// action.
+	_, err := introspect.Introspect(ctx, s.db, "sqlite", introspect.WithPackage("models"))
+
+	// assert.
+	s.ErrorIs(err, context.Canceled)
+}
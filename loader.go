@@ -1,29 +1,247 @@
 package morph
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/fs"
 	"os"
+	pathpkg "path"
+	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
+	"sync"
 
+	"github.com/BurntSushi/toml"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"gopkg.in/yaml.v2"
 )
 
-// Loaders is the collection of loaders by file extension.
-var Loaders = map[string]Loader{
-	"yaml": YAMLLoader{},
-	"yml":  YAMLLoader{},
-	"json": JSONLoader{},
+var (
+	// Loaders is the collection of loaders by file extension. Modifying Loaders
+	// directly is not safe while loading concurrently, so prefer RegisterLoader.
+	Loaders = map[string]Loader{
+		"yaml": YAMLLoader{},
+		"yml":  YAMLLoader{},
+		"json": JSONLoader{},
+		"toml": TOMLLoader{},
+		"hcl":  HCLLoader{},
+	}
+
+	// loadersMu guards concurrent access to Loaders.
+	loadersMu sync.RWMutex
+)
+
+// RegisterLoader registers the loader for files with the provided extension, replacing
+// any loader previously registered for it. Providing a nil loader removes the registration.
+// It is safe for concurrent use.
+func RegisterLoader(ext string, loader Loader) {
+	loadersMu.Lock()
+	defer loadersMu.Unlock()
+	ext = strings.ToLower(strings.TrimPrefix(ext, "."))
+	if loader == nil {
+		delete(Loaders, ext)
+		return
+	}
+	Loaders[ext] = loader
 }
 
-// Load loads the configuration from the provided file.
+// Load loads the configuration from the provided file. The loader is chosen using the
+// file extension, or by detecting the format of the contents when the extension is
+// missing or has no registered loader.
 func Load(path string) (Configuration, error) {
-	extension := path[strings.LastIndex(path, ".")+1:]
+	ext := extension(path)
+	var data []byte
+	if _, ok := lookupLoader(ext); !ok {
+		var err error
+		if data, err = os.ReadFile(path); err != nil {
+			return Configuration{}, err
+		}
+		ext = detect(data)
+	}
+
+	loader, err := loaderFor(ext)
+	if err != nil {
+		return Configuration{}, err
+	}
+	if l, ok := loader.(contentLoader); ok && data != nil {
+		return l.load(data, path, osIncluder(filepath.Dir(path)))
+	}
+	return loader.Load(path)
+}
+
+// LoadFS loads the configuration from the provided file within the provided file system.
+// The loader is chosen in the same way as Load.
+func LoadFS(fsys fs.FS, path string) (Configuration, error) {
+	ext := extension(path)
+	var data []byte
+	if _, ok := lookupLoader(ext); !ok {
+		var err error
+		if data, err = fs.ReadFile(fsys, path); err != nil {
+			return Configuration{}, err
+		}
+		ext = detect(data)
+	}
+
+	loader, err := loaderFor(ext)
+	if err != nil {
+		return Configuration{}, err
+	}
+
+	if l, ok := loader.(contentLoader); ok && data != nil {
+		return l.load(data, path, fsIncluder(fsys, pathpkg.Dir(path)))
+	}
+
+	if l, ok := loader.(FSLoader); ok {
+		return l.LoadFS(fsys, path)
+	}
+
+	if l, ok := loader.(BytesLoader); ok {
+		if data == nil {
+			if data, err = fs.ReadFile(fsys, path); err != nil {
+				return Configuration{}, err
+			}
+		}
+		return l.LoadBytes(data)
+	}
+
+	return Configuration{}, fmt.Errorf("morph: loader for files with %q extension cannot load from a file system", ext)
+}
+
+// LoadReader loads the configuration in the provided format, such as "json" or "yaml",
+// from the provided reader. The format is detected from the contents when it is empty
+// or has no registered loader.
+func LoadReader(r io.Reader, format string) (Configuration, error) {
+	format = strings.ToLower(strings.TrimPrefix(format, "."))
+	var data []byte
+	if _, ok := lookupLoader(format); !ok {
+		var err error
+		if data, err = io.ReadAll(r); err != nil {
+			return Configuration{}, err
+		}
+		format, r = detect(data), bytes.NewReader(data)
+	}
+
+	loader, err := loaderFor(format)
+	if err != nil {
+		return Configuration{}, err
+	}
+
+	if l, ok := loader.(BytesLoader); ok && data != nil {
+		return l.LoadBytes(data)
+	}
+
+	if l, ok := loader.(ReaderLoader); ok {
+		return l.LoadReader(r)
+	}
+
+	if l, ok := loader.(BytesLoader); ok {
+		if data, err = io.ReadAll(r); err != nil {
+			return Configuration{}, err
+		}
+		return l.LoadBytes(data)
+	}
+
+	return Configuration{}, fmt.Errorf("morph: loader for files with %q extension cannot load from a reader", format)
+}
+
+// LoadDir loads and merges the configurations from the files within the provided directory
+// that have a registered loader or no extension, in lexical order. Subdirectories and files
+// with other extensions are ignored. See Merge for how the configurations are combined.
+func LoadDir(dir string) (Configuration, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return Configuration{}, err
+	}
+
+	var paths []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if ext := extension(entry.Name()); ext == "" {
+			paths = append(paths, filepath.Join(dir, entry.Name()))
+		} else if _, ok := lookupLoader(ext); ok {
+			paths = append(paths, filepath.Join(dir, entry.Name()))
+		}
+	}
+	return loadAll(paths)
+}
+
+// LoadGlob loads and merges the configurations from the files matching the provided pattern,
+// in lexical order. See Merge for how the configurations are combined.
+func LoadGlob(pattern string) (Configuration, error) {
+	paths, err := filepath.Glob(pattern)
+	if err != nil {
+		return Configuration{}, err
+	}
+	sort.Strings(paths)
+	return loadAll(paths)
+}
+
+// loadAll loads and merges the configurations from the provided files.
+func loadAll(paths []string) (Configuration, error) {
+	configs := make([]Configuration, 0, len(paths))
+	for _, path := range paths {
+		c, err := Load(path)
+		if err != nil {
+			return Configuration{}, fmt.Errorf("morph: unable to load %q: %w", path, err)
+		}
+		configs = append(configs, c)
+	}
+	return Merge(configs...)
+}
+
+// extension retrieves the lowercase file extension for the provided path, without the leading dot.
+func extension(path string) string {
+	return strings.ToLower(strings.TrimPrefix(filepath.Ext(path), "."))
+}
+
+// tomlKeyValue matches a line that begins with a TOML key/value pair, such as name = "users"
+// or a dotted key like table.name = "users".
+var tomlKeyValue = regexp.MustCompile(`^(?:[A-Za-z0-9_-]+|"[^"]*"|'[^']*')(?:[ \t]*\.[ \t]*(?:[A-Za-z0-9_-]+|"[^"]*"|'[^']*'))*[ \t]*=`)
+
+// detect determines the format of the provided configuration contents, ignoring leading
+// blank lines and comments. Contents that begin with an object are JSON, contents that begin
+// with a table header or key/value pair are TOML, and all other contents are YAML.
+func detect(data []byte) string {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	for len(data) > 0 {
+		var line []byte
+		line, data, _ = bytes.Cut(data, []byte("\n"))
+		line = bytes.TrimSpace(line)
+		switch {
+		case len(line) == 0, line[0] == '#':
+			continue
+		case line[0] == '{':
+			return "json"
+		case line[0] == '[', tomlKeyValue.Match(line):
+			return "toml"
+		}
+		break
+	}
+	return "yaml"
+}
+
+// lookupLoader retrieves the loader registered for the provided file extension.
+func lookupLoader(extension string) (Loader, bool) {
+	loadersMu.RLock()
+	defer loadersMu.RUnlock()
 	loader, ok := Loaders[extension]
+	return loader, ok
+}
+
+// loaderFor retrieves the loader for the provided file extension.
+func loaderFor(extension string) (Loader, error) {
+	loader, ok := lookupLoader(extension)
 	if !ok {
-		return Configuration{}, fmt.Errorf("morph: no loader for files with %q extension", extension)
+		return nil, fmt.Errorf("morph: no loader for files with %q extension", extension)
 	}
-	return loader.Load(path)
+	return loader, nil
 }
 
 // Loader loads the cofiguration from the provided file.
@@ -31,24 +249,253 @@ type Loader interface {
 	Load(path string) (Configuration, error)
 }
 
-type JSONLoader struct{}
+// FSLoader loads the configuration from the provided file within a file system.
+type FSLoader interface {
+	LoadFS(fsys fs.FS, path string) (Configuration, error)
+}
+
+// ReaderLoader loads the configuration from the provided reader.
+type ReaderLoader interface {
+	LoadReader(r io.Reader) (Configuration, error)
+}
+
+// BytesLoader loads the configuration by decoding the provided bytes.
+type BytesLoader interface {
+	LoadBytes(data []byte) (Configuration, error)
+}
+
+// contentLoader loads the configuration from the contents of the provided file, which are
+// already read, resolving included column fragments using the provided includer. The loaders
+// of this package implement it so that files whose format is detected are only read once.
+type contentLoader interface {
+	load(data []byte, file string, include includer) (Configuration, error)
+}
+
+// JSONLoader loads configurations from JSON documents.
+type JSONLoader struct {
+	// ValidateSchema indicates if documents are validated against the JSON Schema for the
+	// configuration format before they are decoded. See ValidateSchema.
+	ValidateSchema bool
 
-// Load loads the configuration from the JSON file provided.
+	// IncludeDir is the directory that the column fragments included by documents loaded
+	// from readers or bytes are resolved relative to. Such documents cannot include column
+	// fragments when it is empty.
+	IncludeDir string
+
+	// Interpolate indicates if the environment variables referenced within the values of
+	// documents, such as ${NAME}, are interpolated. Values are kept as written otherwise.
+	Interpolate bool
+}
+
+// Load loads the configuration from the JSON file provided. Included column fragments
+// are resolved relative to the directory of the file, and must be within it.
 func (l JSONLoader) Load(path string) (c Configuration, err error) {
 	var file []byte
 	if file, err = os.ReadFile(path); err != nil {
 		return
 	}
-	return c, json.Unmarshal(file, &c)
+	return l.load(file, path, osIncluder(filepath.Dir(path)))
 }
 
-type YAMLLoader struct{}
+// LoadFS loads the configuration from the JSON file provided within the file system.
+// Included column fragments are resolved relative to the directory of the file, and must
+// be within it.
+func (l JSONLoader) LoadFS(fsys fs.FS, path string) (c Configuration, err error) {
+	var file []byte
+	if file, err = fs.ReadFile(fsys, path); err != nil {
+		return
+	}
+	return l.load(file, path, fsIncluder(fsys, pathpkg.Dir(path)))
+}
 
-// Load loads the configuration from the YAML file provided.
+// LoadReader loads the configuration from the JSON provided by the reader.
+func (l JSONLoader) LoadReader(r io.Reader) (c Configuration, err error) {
+	var data []byte
+	if data, err = io.ReadAll(r); err != nil {
+		return
+	}
+	return l.LoadBytes(data)
+}
+
+// LoadBytes loads the configuration from the JSON bytes provided. Included column
+// fragments are resolved relative to the include directory of the loader.
+func (l JSONLoader) LoadBytes(data []byte) (c Configuration, err error) {
+	return l.load(data, "", osIncluder(l.IncludeDir))
+}
+
+// load decodes the configuration from the JSON bytes provided, and then expands its
+// includes and, when enabled, environment variables.
+func (l JSONLoader) load(data []byte, file string, include includer) (c Configuration, err error) {
+	if l.ValidateSchema {
+		if err = validateSchema(data, "json", file); err != nil {
+			return
+		}
+	}
+	if err = json.Unmarshal(data, &c); err != nil {
+		return
+	}
+	c.file, c.positions = file, jsonPositions(data)
+	err = c.expand(include, l.Interpolate)
+	return
+}
+
+// YAMLLoader loads configurations from YAML documents.
+type YAMLLoader struct {
+	// ValidateSchema indicates if documents are validated against the JSON Schema for the
+	// configuration format before they are decoded. See ValidateSchema.
+	ValidateSchema bool
+
+	// IncludeDir is the directory that the column fragments included by documents loaded
+	// from readers or bytes are resolved relative to. Such documents cannot include column
+	// fragments when it is empty.
+	IncludeDir string
+
+	// Interpolate indicates if the environment variables referenced within the values of
+	// documents, such as ${NAME}, are interpolated. Values are kept as written otherwise.
+	Interpolate bool
+}
+
+// Load loads the configuration from the YAML file provided. Included column fragments
+// are resolved relative to the directory of the file, and must be within it.
 func (l YAMLLoader) Load(path string) (c Configuration, err error) {
 	var file []byte
 	if file, err = os.ReadFile(path); err != nil {
 		return
 	}
-	return c, yaml.Unmarshal(file, &c)
+	return l.load(file, path, osIncluder(filepath.Dir(path)))
+}
+
+// LoadFS loads the configuration from the YAML file provided within the file system.
+// Included column fragments are resolved relative to the directory of the file, and must
+// be within it.
+func (l YAMLLoader) LoadFS(fsys fs.FS, path string) (c Configuration, err error) {
+	var file []byte
+	if file, err = fs.ReadFile(fsys, path); err != nil {
+		return
+	}
+	return l.load(file, path, fsIncluder(fsys, pathpkg.Dir(path)))
+}
+
+// LoadReader loads the configuration from the YAML provided by the reader.
+func (l YAMLLoader) LoadReader(r io.Reader) (c Configuration, err error) {
+	var data []byte
+	if data, err = io.ReadAll(r); err != nil {
+		return
+	}
+	return l.LoadBytes(data)
+}
+
+// LoadBytes loads the configuration from the YAML bytes provided. Included column
+// fragments are resolved relative to the include directory of the loader.
+func (l YAMLLoader) LoadBytes(data []byte) (c Configuration, err error) {
+	return l.load(data, "", osIncluder(l.IncludeDir))
+}
+
+// load decodes the configuration from the YAML bytes provided, and then expands its
+// includes and, when enabled, environment variables.
+func (l YAMLLoader) load(data []byte, file string, include includer) (c Configuration, err error) {
+	if l.ValidateSchema {
+		if err = validateSchema(data, "yaml", file); err != nil {
+			return
+		}
+	}
+	if err = yaml.Unmarshal(data, &c); err != nil {
+		return
+	}
+	c.file, c.positions = file, yamlPositions(data)
+	err = c.expand(include, l.Interpolate)
+	return
+}
+
+type TOMLLoader struct{}
+
+// Load loads the configuration from the TOML file provided.
+func (l TOMLLoader) Load(path string) (c Configuration, err error) {
+	var file []byte
+	if file, err = os.ReadFile(path); err != nil {
+		return
+	}
+	return l.load(file, path, nil)
+}
+
+// LoadFS loads the configuration from the TOML file provided within the file system.
+func (l TOMLLoader) LoadFS(fsys fs.FS, path string) (c Configuration, err error) {
+	var file []byte
+	if file, err = fs.ReadFile(fsys, path); err != nil {
+		return
+	}
+	return l.load(file, path, nil)
+}
+
+// LoadReader loads the configuration from the TOML provided by the reader.
+func (l TOMLLoader) LoadReader(r io.Reader) (c Configuration, err error) {
+	var data []byte
+	if data, err = io.ReadAll(r); err != nil {
+		return
+	}
+	return l.LoadBytes(data)
+}
+
+// LoadBytes loads the configuration from the TOML bytes provided.
+func (l TOMLLoader) LoadBytes(data []byte) (c Configuration, err error) {
+	return l.load(data, "", nil)
+}
+
+// load decodes the configuration from the TOML bytes provided, which were read from the
+// provided file, if any. TOML documents cannot include column fragments.
+func (l TOMLLoader) load(data []byte, file string, _ includer) (c Configuration, err error) {
+	if err = toml.Unmarshal(data, &c); err != nil {
+		return
+	}
+	c.file = file
+	return
+}
+
+type HCLLoader struct{}
+
+// Load loads the configuration from the HCL file provided.
+func (l HCLLoader) Load(path string) (c Configuration, err error) {
+	var file []byte
+	if file, err = os.ReadFile(path); err != nil {
+		return
+	}
+	return l.load(file, path, nil)
+}
+
+// LoadFS loads the configuration from the HCL file provided within the file system.
+func (l HCLLoader) LoadFS(fsys fs.FS, path string) (c Configuration, err error) {
+	var file []byte
+	if file, err = fs.ReadFile(fsys, path); err != nil {
+		return
+	}
+	return l.load(file, path, nil)
+}
+
+// LoadReader loads the configuration from the HCL provided by the reader.
+func (l HCLLoader) LoadReader(r io.Reader) (c Configuration, err error) {
+	var data []byte
+	if data, err = io.ReadAll(r); err != nil {
+		return
+	}
+	return l.LoadBytes(data)
+}
+
+// LoadBytes loads the configuration from the HCL bytes provided.
+func (l HCLLoader) LoadBytes(data []byte) (c Configuration, err error) {
+	return l.load(data, "", nil)
+}
+
+// load decodes the configuration from the HCL bytes provided, which were read from the
+// provided file, if any, so that diagnostics and positions refer to it. HCL documents
+// cannot include column fragments.
+func (l HCLLoader) load(data []byte, file string, _ includer) (c Configuration, err error) {
+	f, diags := hclsyntax.ParseConfig(data, file, hcl.InitialPos)
+	if diags.HasErrors() {
+		return c, diags
+	}
+	if diags = gohcl.DecodeBody(f.Body, nil, &c); diags.HasErrors() {
+		return c, diags
+	}
+	c.file, c.positions = file, hclPositions(f.Body.(*hclsyntax.Body))
+	return
 }
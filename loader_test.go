@@ -2,7 +2,13 @@ package morph_test
 
 import (
 	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"testing/fstest"
 
 	"github.com/freerware/morph"
 	"github.com/freerware/morph/mocks"
@@ -25,9 +31,9 @@ func (s *LoadTestSuite) SetupTest() {
 	s.yamlLoader = &mocks.Loader{}
 	s.ymlLoader = &mocks.Loader{}
 	s.jsonLoader = &mocks.Loader{}
-	morph.Loaders["yaml"] = s.yamlLoader
-	morph.Loaders["yml"] = s.ymlLoader
-	morph.Loaders["json"] = s.jsonLoader
+	morph.RegisterLoader("yaml", s.yamlLoader)
+	morph.RegisterLoader("yml", s.ymlLoader)
+	morph.RegisterLoader("json", s.jsonLoader)
 }
 
 func (s *LoadTestSuite) TestLoadYAML() {
@@ -171,10 +177,171 @@ func (s *LoadTestSuite) TestLoad_MissingLoader() {
 	s.Require().Error(err)
 }
 
+func (s *LoadTestSuite) TestLoad_Detected() {
+	// arrange.
+	path := filepath.Join(s.T().TempDir(), "configs.d", "users")
+	s.Require().NoError(os.Mkdir(filepath.Dir(path), 0o700))
+	s.Require().NoError(os.WriteFile(path, []byte("\n  {\"tables\": []}"), 0o600))
+	expectedConfig := morph.Configuration{Tables: []morph.TableConfiguration{{TypeName: "example.User"}}}
+	s.jsonLoader.On("Load", path).Return(expectedConfig, nil)
+
+	// action.
+	actualConfig, err := morph.Load(path)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal(expectedConfig, actualConfig)
+}
+
+func (s *LoadTestSuite) TestRegisterLoader() {
+	// arrange.
+	path := "./test_config.XML"
+	loader := &mocks.Loader{}
+	expectedConfig := morph.Configuration{Tables: []morph.TableConfiguration{{TypeName: "example.User"}}}
+	loader.On("Load", path).Return(expectedConfig, nil)
+	morph.RegisterLoader(".xml", loader)
+
+	// action.
+	actualConfig, err := morph.Load(path)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal(expectedConfig, actualConfig)
+}
+
+func (s *LoadTestSuite) TestRegisterLoader_Concurrent() {
+	// arrange.
+	var wg sync.WaitGroup
+	loader := &mocks.Loader{}
+	loader.On("Load", "./test_config.xml").Return(morph.Configuration{}, nil)
+
+	// action.
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			morph.RegisterLoader("xml", loader)
+		}()
+		go func() {
+			defer wg.Done()
+			morph.Load("./test_config.xml")
+		}()
+	}
+	wg.Wait()
+
+	// assert.
+	morph.RegisterLoader("xml", loader)
+	_, err := morph.Load("./test_config.xml")
+	s.Require().NoError(err)
+}
+
+func (s *LoadTestSuite) TestLoadFS() {
+	// arrange.
+	path := "configs/test_config.json"
+	data := []byte(`{"tables": [{"typeName": "example.User"}]}`)
+	fsys := fstest.MapFS{path: &fstest.MapFile{Data: data}}
+	loader := bytesLoader{&mocks.Loader{}, &mocks.BytesLoader{}}
+	expectedConfig := morph.Configuration{Tables: []morph.TableConfiguration{{TypeName: "example.User"}}}
+	loader.BytesLoader.On("LoadBytes", data).Return(expectedConfig, nil)
+	morph.RegisterLoader("json", loader)
+
+	// action.
+	actualConfig, err := morph.LoadFS(fsys, path)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal(expectedConfig, actualConfig)
+}
+
+func (s *LoadTestSuite) TestLoadFS_MissingFile() {
+	// arrange.
+	morph.RegisterLoader("json", bytesLoader{&mocks.Loader{}, &mocks.BytesLoader{}})
+
+	// action.
+	_, err := morph.LoadFS(fstest.MapFS{}, "missing_test_config.json")
+
+	// assert.
+	s.Require().Error(err)
+}
+
+func (s *LoadTestSuite) TestLoadFS_UnsupportedLoader() {
+	// arrange.
+	fsys := fstest.MapFS{"test_config.yaml": &fstest.MapFile{}}
+
+	// action.
+	_, err := morph.LoadFS(fsys, "test_config.yaml")
+
+	// assert.
+	s.Require().Error(err)
+}
+
+func (s *LoadTestSuite) TestLoadFS_MissingLoader() {
+	// action.
+	_, err := morph.LoadFS(fstest.MapFS{}, "test_config.txt")
+
+	// assert.
+	s.Require().Error(err)
+}
+
+func (s *LoadTestSuite) TestLoadReader() {
+	// arrange.
+	data := `tables: [{typeName: example.User}]`
+	loader := bytesLoader{&mocks.Loader{}, &mocks.BytesLoader{}}
+	expectedConfig := morph.Configuration{Tables: []morph.TableConfiguration{{TypeName: "example.User"}}}
+	loader.BytesLoader.On("LoadBytes", []byte(data)).Return(expectedConfig, nil)
+	morph.RegisterLoader("yaml", loader)
+
+	// action.
+	actualConfig, err := morph.LoadReader(strings.NewReader(data), "yaml")
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal(expectedConfig, actualConfig)
+}
+
+func (s *LoadTestSuite) TestLoadReader_Detected() {
+	// arrange.
+	data := `{"tables": [{"typeName": "example.User"}]}`
+	loader := bytesLoader{&mocks.Loader{}, &mocks.BytesLoader{}}
+	expectedConfig := morph.Configuration{Tables: []morph.TableConfiguration{{TypeName: "example.User"}}}
+	loader.BytesLoader.On("LoadBytes", []byte(data)).Return(expectedConfig, nil)
+	morph.RegisterLoader("json", loader)
+
+	// action.
+	actualConfig, err := morph.LoadReader(strings.NewReader(data), "")
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal(expectedConfig, actualConfig)
+}
+
+func (s *LoadTestSuite) TestLoadReader_UnsupportedLoader() {
+	// action.
+	_, err := morph.LoadReader(strings.NewReader(""), "yaml")
+
+	// assert.
+	s.Require().Error(err)
+}
+
+func (s *LoadTestSuite) TestLoadReader_MissingLoader() {
+	// action.
+	_, err := morph.LoadReader(strings.NewReader(""), "txt")
+
+	// assert.
+	s.Require().Error(err)
+}
+
 func (s *LoadTestSuite) TearDownTest() {
-	morph.Loaders["yaml"] = morph.YAMLLoader{}
-	morph.Loaders["yml"] = morph.YAMLLoader{}
-	morph.Loaders["json"] = morph.JSONLoader{}
+	morph.RegisterLoader("yaml", morph.YAMLLoader{})
+	morph.RegisterLoader("yml", morph.YAMLLoader{})
+	morph.RegisterLoader("json", morph.JSONLoader{})
+	morph.RegisterLoader("xml", nil)
+}
+
+// bytesLoader is a loader that can only load configurations from files or bytes.
+type bytesLoader struct {
+	*mocks.Loader
+	*mocks.BytesLoader
 }
 
 type JSONLoaderTestSuite struct {
@@ -248,3 +415,526 @@ func (s *YAMLLoaderTestSuite) TestLoad_MissingFile() {
 	// assert.
 	s.Require().Error(err)
 }
+
+func (s *JSONLoaderTestSuite) TestLoadFS() {
+	// arrange.
+	path := "test_config.json"
+
+	// action.
+	c, err := s.sut.(morph.FSLoader).LoadFS(os.DirFS("."), path)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().Len(c.Tables, 1)
+	s.Equal("user", c.Tables[0].Name)
+}
+
+func (s *JSONLoaderTestSuite) TestLoadFS_MissingFile() {
+	// arrange.
+	path := "missing_test_config.json"
+
+	// action.
+	_, err := s.sut.(morph.FSLoader).LoadFS(os.DirFS("."), path)
+
+	// assert.
+	s.Require().Error(err)
+}
+
+func (s *JSONLoaderTestSuite) TestLoadReader() {
+	// arrange.
+	r := strings.NewReader(`{"tables": [{"typeName": "example.User", "name": "user"}]}`)
+
+	// action.
+	c, err := s.sut.(morph.ReaderLoader).LoadReader(r)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().Len(c.Tables, 1)
+	s.Equal("example.User", c.Tables[0].TypeName)
+}
+
+func (s *JSONLoaderTestSuite) TestLoadBytes() {
+	// arrange.
+	data := []byte(`{"tables": [{"typeName": "example.User", "name": "user"}]}`)
+
+	// action.
+	c, err := s.sut.(morph.BytesLoader).LoadBytes(data)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().Len(c.Tables, 1)
+	s.Equal("user", c.Tables[0].Name)
+}
+
+func (s *JSONLoaderTestSuite) TestLoadBytes_Invalid() {
+	// arrange.
+	data := []byte("[")
+
+	// action.
+	_, err := s.sut.(morph.BytesLoader).LoadBytes(data)
+
+	// assert.
+	s.Require().Error(err)
+}
+
+func (s *YAMLLoaderTestSuite) TestLoadFS() {
+	// arrange.
+	path := "test_config.yaml"
+
+	// action.
+	c, err := s.sut.(morph.FSLoader).LoadFS(os.DirFS("."), path)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().Len(c.Tables, 1)
+	s.Equal("user", c.Tables[0].Name)
+}
+
+func (s *YAMLLoaderTestSuite) TestLoadFS_MissingFile() {
+	// arrange.
+	path := "missing_test_config.yaml"
+
+	// action.
+	_, err := s.sut.(morph.FSLoader).LoadFS(os.DirFS("."), path)
+
+	// assert.
+	s.Require().Error(err)
+}
+
+func (s *YAMLLoaderTestSuite) TestLoadReader() {
+	// arrange.
+	r := strings.NewReader("tables:\n  - typeName: example.User\n    name: user\n")
+
+	// action.
+	c, err := s.sut.(morph.ReaderLoader).LoadReader(r)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().Len(c.Tables, 1)
+	s.Equal("example.User", c.Tables[0].TypeName)
+}
+
+func (s *YAMLLoaderTestSuite) TestLoadBytes() {
+	// arrange.
+	data := []byte("tables:\n  - typeName: example.User\n    name: user\n")
+
+	// action.
+	c, err := s.sut.(morph.BytesLoader).LoadBytes(data)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().Len(c.Tables, 1)
+	s.Equal("user", c.Tables[0].Name)
+}
+
+func (s *YAMLLoaderTestSuite) TestLoadBytes_Invalid() {
+	// arrange.
+	data := []byte("[")
+
+	// action.
+	_, err := s.sut.(morph.BytesLoader).LoadBytes(data)
+
+	// assert.
+	s.Require().Error(err)
+}
+
+type TOMLLoaderTestSuite struct {
+	suite.Suite
+
+	sut morph.Loader
+}
+
+func TestTOMLLoaderTestSuite(t *testing.T) {
+	suite.Run(t, new(TOMLLoaderTestSuite))
+}
+
+func (s *TOMLLoaderTestSuite) SetupTest() {
+	s.sut = morph.TOMLLoader{}
+}
+
+func (s *TOMLLoaderTestSuite) TestLoad() {
+	// arrange.
+	path := "./test_config.toml"
+	expectedConfig := morph.Configuration{
+		Tables: []morph.TableConfiguration{
+			{
+				TypeName: "example.User",
+				Name:     "user",
+				Alias:    "U",
+				Columns: []morph.ColumnConfiguration{
+					{Name: "uuid", Field: "UUID", FieldType: "string", FieldStrategy: morph.FieldStrategyMethod, PrimaryKey: true},
+					{Name: "username", Field: "Username", FieldType: "string", FieldStrategy: morph.FieldStrategyMethod},
+					{Name: "password", Field: "Password", FieldType: "string", FieldStrategy: morph.FieldStrategyMethod},
+					{Name: "givenName", Field: "GivenName", FieldType: "string", FieldStrategy: morph.FieldStrategyMethod},
+					{Name: "surname", Field: "Surname", FieldType: "string", FieldStrategy: morph.FieldStrategyMethod},
+				},
+			},
+		},
+	}
+
+	// action.
+	actualConfig, err := s.sut.Load(path)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal(expectedConfig.Tables, actualConfig.Tables)
+}
+
+func (s *TOMLLoaderTestSuite) TestLoad_MissingFile() {
+	// arrange.
+	path := "./missing_test_config.toml"
+
+	// action.
+	_, err := s.sut.Load(path)
+
+	// assert.
+	s.Require().Error(err)
+}
+
+func (s *TOMLLoaderTestSuite) TestLoadFS() {
+	// arrange.
+	path := "test_config.toml"
+
+	// action.
+	c, err := s.sut.(morph.FSLoader).LoadFS(os.DirFS("."), path)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().Len(c.Tables, 1)
+	s.Equal("user", c.Tables[0].Name)
+}
+
+func (s *TOMLLoaderTestSuite) TestLoadReader() {
+	// arrange.
+	r := strings.NewReader("[[tables]]\ntypeName = \"example.User\"\nname = \"user\"\n")
+
+	// action.
+	c, err := s.sut.(morph.ReaderLoader).LoadReader(r)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().Len(c.Tables, 1)
+	s.Equal("example.User", c.Tables[0].TypeName)
+}
+
+func (s *TOMLLoaderTestSuite) TestLoadBytes_Invalid() {
+	// arrange.
+	data := []byte("[")
+
+	// action.
+	_, err := s.sut.(morph.BytesLoader).LoadBytes(data)
+
+	// assert.
+	s.Require().Error(err)
+}
+
+type HCLLoaderTestSuite struct {
+	suite.Suite
+
+	sut morph.Loader
+}
+
+func TestHCLLoaderTestSuite(t *testing.T) {
+	suite.Run(t, new(HCLLoaderTestSuite))
+}
+
+func (s *HCLLoaderTestSuite) SetupTest() {
+	s.sut = morph.HCLLoader{}
+}
+
+func (s *HCLLoaderTestSuite) TestLoad() {
+	// arrange.
+	path := "./test_config.hcl"
+	expectedConfig := morph.Configuration{
+		Tables: []morph.TableConfiguration{
+			{
+				TypeName: "example.User",
+				Name:     "user",
+				Alias:    "U",
+				Columns: []morph.ColumnConfiguration{
+					{Name: "uuid", Field: "UUID", FieldType: "string", FieldStrategy: morph.FieldStrategyMethod, PrimaryKey: true},
+					{Name: "username", Field: "Username", FieldType: "string", FieldStrategy: morph.FieldStrategyMethod},
+					{Name: "password", Field: "Password", FieldType: "string", FieldStrategy: morph.FieldStrategyMethod},
+					{Name: "givenName", Field: "GivenName", FieldType: "string", FieldStrategy: morph.FieldStrategyMethod},
+					{Name: "surname", Field: "Surname", FieldType: "string", FieldStrategy: morph.FieldStrategyMethod},
+				},
+			},
+		},
+	}
+
+	// action.
+	actualConfig, err := s.sut.Load(path)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal(expectedConfig.Tables, actualConfig.Tables)
+}
+
+func (s *HCLLoaderTestSuite) TestLoad_MissingFile() {
+	// arrange.
+	path := "./missing_test_config.hcl"
+
+	// action.
+	_, err := s.sut.Load(path)
+
+	// assert.
+	s.Require().Error(err)
+}
+
+func (s *HCLLoaderTestSuite) TestLoadFS() {
+	// arrange.
+	path := "test_config.hcl"
+
+	// action.
+	c, err := s.sut.(morph.FSLoader).LoadFS(os.DirFS("."), path)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().Len(c.Tables, 1)
+	s.Equal("user", c.Tables[0].Name)
+}
+
+func (s *HCLLoaderTestSuite) TestLoadReader() {
+	// arrange.
+	r := strings.NewReader("table {\n  typeName = \"example.User\"\n  name = \"user\"\n}\n")
+
+	// action.
+	c, err := s.sut.(morph.ReaderLoader).LoadReader(r)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().Len(c.Tables, 1)
+	s.Equal("example.User", c.Tables[0].TypeName)
+}
+
+func (s *HCLLoaderTestSuite) TestLoadBytes_Invalid() {
+	// arrange.
+	data := []byte("[")
+
+	// action.
+	_, err := s.sut.(morph.BytesLoader).LoadBytes(data)
+
+	// assert.
+	s.Require().Error(err)
+}
+
+func (s *HCLLoaderTestSuite) TestLoad_InvalidFile() {
+	// arrange.
+	path := filepath.Join(s.T().TempDir(), "invalid.hcl")
+	s.Require().NoError(os.WriteFile(path, []byte("table {\n  name = \n}\n"), 0o600))
+
+	// action.
+	_, err := s.sut.Load(path)
+
+	// assert.
+	s.Require().Error(err)
+	s.ErrorContains(err, path+":2,")
+}
+
+func (s *HCLLoaderTestSuite) TestLoad_Positions() {
+	// arrange.
+	path := "./test_config.hcl"
+
+	// action.
+	c, err := s.sut.Load(path)
+	s.Require().NoError(err)
+	c.Tables[0].Columns[1].FieldStrategy = "getter"
+	err = c.Validate()
+
+	// assert.
+	s.EqualError(err, `morph: ./test_config.hcl:14:3: table "user", column "username": column field strategy must be "struct_field" or "method"`)
+}
+
+type LoadDirTestSuite struct {
+	suite.Suite
+
+	dir string
+}
+
+func TestLoadDirTestSuite(t *testing.T) {
+	suite.Run(t, new(LoadDirTestSuite))
+}
+
+func (s *LoadDirTestSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+}
+
+func (s *LoadDirTestSuite) write(name, contents string) {
+	s.Require().NoError(os.WriteFile(filepath.Join(s.dir, name), []byte(contents), 0o600))
+}
+
+func (s *LoadDirTestSuite) TestLoadDir() {
+	// arrange.
+	s.write("b_users.yaml", "tables:\n  - typeName: example.User\n    name: users\n    alias: U\n")
+	s.write("a_ships.json", `{"tables": [{"typeName": "example.Ship", "name": "ships", "alias": "S"}]}`)
+	s.write("z_staging.toml", "[[overrides]]\ntypeName = \"example.User\"\nname = \"staging.users\"\n")
+	s.write("README.md", "# mappings")
+	s.Require().NoError(os.Mkdir(filepath.Join(s.dir, "nested.yaml"), 0o700))
+
+	// action.
+	c, err := morph.LoadDir(s.dir)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().Len(c.Tables, 2)
+	s.Equal("ships", c.Tables[0].Name)
+	s.Equal("staging.users", c.Tables[1].Name)
+	s.Equal("U", c.Tables[1].Alias)
+	s.Empty(c.Overrides)
+}
+
+func (s *LoadDirTestSuite) TestLoadDir_Detected() {
+	// arrange.
+	s.write("users", "tables:\n  - typeName: example.User\n    name: users\n")
+	s.write("ships", "\n\t"+`{"tables": [{"typeName": "example.Ship", "name": "ships"}]}`)
+	s.write("vehicles.conf", "[[tables]]\ntypeName = \"example.Vehicle\"\nname = \"vehicles\"\n")
+
+	// action.
+	c, err := morph.LoadDir(s.dir)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().Len(c.Tables, 2)
+	s.Equal("ships", c.Tables[0].Name)
+	s.Equal("users", c.Tables[1].Name)
+}
+
+func (s *LoadDirTestSuite) TestLoad_Detected() {
+	// arrange.
+	s.write("vehicles.conf", "[[tables]]\ntypeName = \"example.Vehicle\"\nname = \"vehicles\"\n")
+
+	// action.
+	c, err := morph.Load(filepath.Join(s.dir, "vehicles.conf"))
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().Len(c.Tables, 1)
+	s.Equal("vehicles", c.Tables[0].Name)
+}
+
+func (s *LoadDirTestSuite) TestLoad_DetectedAfterComments() {
+	tests := []struct {
+		name     string
+		contents string
+	}{
+		{name: "TOMLTableHeader", contents: "# vehicles\n\n[[tables]]\ntypeName = \"example.Vehicle\"\nname = \"vehicles\"\n"},
+		{name: "TOMLKeyValue", contents: "# vehicles\ntables = [{ typeName = \"example.Vehicle\", name = \"vehicles\" }]\n"},
+		{name: "TOMLDottedKey", contents: "overrides = []\n\"tables\" = [{ typeName = \"example.Vehicle\", name = \"vehicles\" }]\n"},
+		{name: "YAML", contents: "# vehicles = cars\ntables:\n  - typeName: example.Vehicle\n    name: vehicles\n"},
+		{name: "JSON", contents: "\n\t{\"tables\": [{\"typeName\": \"example.Vehicle\", \"name\": \"vehicles\"}]}"},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// arrange.
+			s.write("vehicles.conf", test.contents)
+
+			// action.
+			c, err := morph.Load(filepath.Join(s.dir, "vehicles.conf"))
+
+			// assert.
+			s.Require().NoError(err)
+			s.Require().Len(c.Tables, 1)
+			s.Equal("vehicles", c.Tables[0].Name)
+		})
+	}
+}
+
+func (s *LoadDirTestSuite) TestLoadFS_DetectedReadOnce() {
+	// arrange.
+	fsys := &countingFS{FS: fstest.MapFS{
+		"mappings/vehicles": &fstest.MapFile{Data: []byte("tables:\n  - typeName: example.Vehicle\n    name: vehicles\n")},
+	}}
+
+	// action.
+	c, err := morph.LoadFS(fsys, "mappings/vehicles")
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().Len(c.Tables, 1)
+	s.Equal("vehicles", c.Tables[0].Name)
+	s.Equal(1, fsys.opens)
+}
+
+func (s *LoadDirTestSuite) TestLoadDir_MissingDirectory() {
+	// action.
+	_, err := morph.LoadDir(filepath.Join(s.dir, "missing"))
+
+	// assert.
+	s.Require().Error(err)
+}
+
+func (s *LoadDirTestSuite) TestLoadDir_InvalidFile() {
+	// arrange.
+	s.write("users.json", "{")
+
+	// action.
+	_, err := morph.LoadDir(s.dir)
+
+	// assert.
+	s.Require().ErrorContains(err, "users.json")
+}
+
+func (s *LoadDirTestSuite) TestLoadDir_Duplicates() {
+	// arrange.
+	s.write("a.yaml", "tables:\n  - typeName: example.User\n    name: users\n")
+	s.write("b.yaml", "tables:\n  - typeName: example.Admin\n    name: users\n  - typeName: example.User\n    name: people\n")
+
+	// action.
+	_, err := morph.LoadDir(s.dir)
+
+	// assert.
+	s.Require().ErrorIs(err, morph.ErrDuplicateTableName)
+	s.Require().ErrorIs(err, morph.ErrDuplicateTypeName)
+	s.ErrorContains(err, filepath.Join(s.dir, "b.yaml")+":2:5")
+	s.ErrorContains(err, filepath.Join(s.dir, "b.yaml")+":4:5")
+}
+
+func (s *LoadDirTestSuite) TestLoadDir_UnmatchedOverride() {
+	// arrange.
+	s.write("users.yaml", "tables:\n  - typeName: example.User\n    name: users\n")
+	s.write("staging.yaml", "overrides:\n  - typeName: example.Missing\n    alias: M\n")
+
+	// action.
+	_, err := morph.LoadDir(s.dir)
+
+	// assert.
+	s.Require().ErrorIs(err, morph.ErrUnmatchedOverride)
+	s.ErrorContains(err, filepath.Join(s.dir, "staging.yaml")+":2:5")
+}
+
+func (s *LoadDirTestSuite) TestLoadGlob() {
+	// arrange.
+	s.write("users.yaml", "tables:\n  - typeName: example.User\n    name: users\n")
+	s.write("ships.yaml", "tables:\n  - typeName: example.Ship\n    name: ships\n")
+	s.write("ships.json", `{"tables": [{"typeName": "example.Ignored", "name": "ignored"}]}`)
+
+	// action.
+	c, err := morph.LoadGlob(filepath.Join(s.dir, "*.yaml"))
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().Len(c.Tables, 2)
+	s.Equal("ships", c.Tables[0].Name)
+	s.Equal("users", c.Tables[1].Name)
+}
+
+func (s *LoadDirTestSuite) TestLoadGlob_InvalidPattern() {
+	// action.
+	_, err := morph.LoadGlob("[")
+
+	// assert.
+	s.Require().Error(err)
+}
+
+// countingFS counts the files opened within the file system.
+type countingFS struct {
+	fs.FS
+
+	opens int
+}
+
+func (f *countingFS) Open(name string) (fs.File, error) {
+	f.opens++
+	return f.FS.Open(name)
+}
@@ -1,6 +1,9 @@
 package morph
 
-import "strings"
+import (
+	"regexp"
+	"strings"
+)
 
 // CaseStrategy is an enumeration of the available case strategies.
 type CaseStrategy string
@@ -68,6 +71,8 @@ type ReflectConfiguration struct {
 	TableAliasLength       *int
 	PrimaryKeyColumns      []string
 	ColumnNameMappings     map[string]string
+	methodExclusion        *regexp.Regexp
+	fieldExclusion         *regexp.Regexp
 }
 
 // HasTableName indicates if the table name is set.
@@ -0,0 +1,209 @@
+package morph
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// Position represents a location within a configuration file.
+type Position struct {
+	File   string
+	Line   int
+	Column int
+}
+
+// IsValid indicates if the position refers to a known line.
+func (p Position) IsValid() bool {
+	return p.Line > 0
+}
+
+// String formats the position as file:line:column, omitting any unknown parts.
+func (p Position) String() string {
+	s := p.File
+	if p.IsValid() {
+		if s != "" {
+			s += ":"
+		}
+		s += strconv.Itoa(p.Line)
+		if p.Column > 0 {
+			s += ":" + strconv.Itoa(p.Column)
+		}
+	}
+	return s
+}
+
+// positions represents the positions of the values within a configuration
+// document, keyed by their path, such as "tables[0].columns[1]".
+type positions map[string]Position
+
+// tablePath retrieves the path for the table at the provided index.
+func tablePath(table int) string {
+	return fmt.Sprintf("tables[%d]", table)
+}
+
+// columnPath retrieves the path for the column at the provided indices.
+func columnPath(table, column int) string {
+	return fmt.Sprintf("tables[%d].columns[%d]", table, column)
+}
+
+// overridePath retrieves the path for the override at the provided index.
+func overridePath(override int) string {
+	return fmt.Sprintf("overrides[%d]", override)
+}
+
+// reindex shifts the table index within the provided path by the provided offset.
+func reindex(path string, offset int) string {
+	if !strings.HasPrefix(path, "tables[") {
+		return path
+	}
+	end := strings.Index(path, "]")
+	index, err := strconv.Atoi(path[len("tables["):end])
+	if err != nil {
+		return path
+	}
+	return tablePath(index+offset) + path[end+1:]
+}
+
+// jsonPositions determines the positions of the values within the provided JSON document.
+// Positions are only determined on a best effort basis, so malformed documents result in
+// partial positions.
+func jsonPositions(data []byte) positions {
+	pos := make(positions)
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	// start determines the position of the next token.
+	start := func() Position {
+		offset := int(dec.InputOffset())
+	skip:
+		for offset < len(data) {
+			switch data[offset] {
+			case ' ', '\t', '\r', '\n', ',', ':':
+				offset++
+			default:
+				break skip
+			}
+		}
+		line, lineStart := 1, 0
+		for i := 0; i < offset && i < len(data); i++ {
+			if data[i] == '\n' {
+				line, lineStart = line+1, i+1
+			}
+		}
+		return Position{Line: line, Column: offset - lineStart + 1}
+	}
+
+	var walk func(path string) error
+	walk = func(path string) error {
+		p := start()
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		pos[path] = p
+
+		switch tok {
+		case json.Delim('{'):
+			for dec.More() {
+				key, err := dec.Token()
+				if err != nil {
+					return err
+				}
+				k, _ := key.(string)
+				if path != "" {
+					k = path + "." + k
+				}
+				if err := walk(k); err != nil {
+					return err
+				}
+			}
+			_, err = dec.Token()
+		case json.Delim('['):
+			for i := 0; dec.More(); i++ {
+				if err := walk(fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+			_, err = dec.Token()
+		}
+		return err
+	}
+
+	_ = walk("")
+	return pos
+}
+
+// yamlPositions determines the positions of the values within the provided YAML document.
+// Positions are only determined on a best effort basis, so malformed documents result in
+// no positions.
+func yamlPositions(data []byte) positions {
+	pos := make(positions)
+	var root yamlv3.Node
+	if err := yamlv3.Unmarshal(data, &root); err != nil {
+		return pos
+	}
+
+	var walk func(path string, node *yamlv3.Node)
+	walk = func(path string, node *yamlv3.Node) {
+		switch node.Kind {
+		case yamlv3.DocumentNode:
+			for _, n := range node.Content {
+				walk(path, n)
+			}
+			return
+		case yamlv3.AliasNode:
+			walk(path, node.Alias)
+			return
+		}
+
+		pos[path] = Position{Line: node.Line, Column: node.Column}
+		switch node.Kind {
+		case yamlv3.MappingNode:
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				k := node.Content[i].Value
+				if path != "" {
+					k = path + "." + k
+				}
+				walk(k, node.Content[i+1])
+			}
+		case yamlv3.SequenceNode:
+			for i, n := range node.Content {
+				walk(fmt.Sprintf("%s[%d]", path, i), n)
+			}
+		}
+	}
+
+	walk("", &root)
+	return pos
+}
+
+// hclPositions determines the positions of the table and column blocks within the provided HCL body.
+func hclPositions(body *hclsyntax.Body) positions {
+	pos := make(positions)
+	at := func(block *hclsyntax.Block) Position {
+		return Position{Line: block.TypeRange.Start.Line, Column: block.TypeRange.Start.Column}
+	}
+
+	i := 0
+	for _, table := range body.Blocks {
+		if table.Type != "table" {
+			continue
+		}
+		pos[tablePath(i)] = at(table)
+		j := 0
+		for _, column := range table.Body.Blocks {
+			if column.Type != "column" {
+				continue
+			}
+			pos[columnPath(i, j)] = at(column)
+			j++
+		}
+		i++
+	}
+	return pos
+}
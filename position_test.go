@@ -0,0 +1,38 @@
+package morph_test
+
+import (
+	"testing"
+
+	"github.com/freerware/morph"
+	"github.com/stretchr/testify/suite"
+)
+
+type PositionTestSuite struct {
+	suite.Suite
+}
+
+func TestPositionTestSuite(t *testing.T) {
+	suite.Run(t, new(PositionTestSuite))
+}
+
+func (s *PositionTestSuite) TestPosition_String() {
+	tests := []struct {
+		name     string
+		position morph.Position
+		expected string
+	}{
+		{name: "Empty", position: morph.Position{}, expected: ""},
+		{name: "FileOnly", position: morph.Position{File: "users.yaml"}, expected: "users.yaml"},
+		{name: "LineAndColumn", position: morph.Position{Line: 3, Column: 7}, expected: "3:7"},
+		{name: "LineOnly", position: morph.Position{File: "users.yaml", Line: 3}, expected: "users.yaml:3"},
+		{name: "Full", position: morph.Position{File: "users.yaml", Line: 3, Column: 7}, expected: "users.yaml:3:7"},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// action + assert.
+			s.Equal(test.expected, test.position.String())
+			s.Equal(test.position.Line > 0, test.position.IsValid())
+		})
+	}
+}
@@ -1,20 +1,128 @@
 package morph
 
 import (
+	"context"
+	"fmt"
+	"reflect"
 	"strconv"
+	"strings"
 	"text/template"
 )
 
 // DefaultPlaceholder represents the default placeholder value used for query generation.
 const DefaultPlaceholder = "?"
 
+// AggregateFunction is an enumeration of the available aggregate functions.
+type AggregateFunction string
+
+const (
+	// AggregateCount counts the rows or the non-null values of a column.
+	AggregateCount AggregateFunction = "COUNT"
+
+	// AggregateSum sums the values of a column.
+	AggregateSum AggregateFunction = "SUM"
+
+	// AggregateMin retrieves the minimum value of a column.
+	AggregateMin AggregateFunction = "MIN"
+
+	// AggregateMax retrieves the maximum value of a column.
+	AggregateMax AggregateFunction = "MAX"
+
+	// AggregateAvg averages the values of a column.
+	AggregateAvg AggregateFunction = "AVG"
+)
+
+// valid indicates if the aggregate function is supported.
+func (f AggregateFunction) valid() bool {
+	switch f {
+	case AggregateCount, AggregateSum, AggregateMin, AggregateMax, AggregateAvg:
+		return true
+	}
+	return false
+}
+
+// LockMode is an enumeration of the available row locking clauses.
+type LockMode string
+
+const (
+	// LockForUpdate locks the selected rows for updating.
+	LockForUpdate LockMode = "FOR UPDATE"
+
+	// LockForShare locks the selected rows for reading.
+	LockForShare LockMode = "FOR SHARE"
+)
+
+// LockWaitPolicy is an enumeration of the available policies for rows that are
+// already locked by another transaction.
+type LockWaitPolicy string
+
+const (
+	// LockNoWait fails immediately when a selected row is already locked.
+	LockNoWait LockWaitPolicy = "NOWAIT"
+
+	// LockSkipLocked omits selected rows that are already locked.
+	LockSkipLocked LockWaitPolicy = "SKIP LOCKED"
+)
+
+// EmptyPredicate represents a condition used to determine if the value of a column is empty.
+type EmptyPredicate func(column Column, value any) bool
+
+var (
+	// EmptyIfNil considers values to be empty when they are nil.
+	EmptyIfNil EmptyPredicate = func(_ Column, value any) bool {
+		return value == nil
+	}
+
+	// EmptyIfZero considers values to be empty when they are nil or the zero value of their type.
+	EmptyIfZero EmptyPredicate = func(_ Column, value any) bool {
+		return value == nil || reflect.ValueOf(value).IsZero()
+	}
+)
+
+// Criteria represents the values that the columns mapped to fields must equal for a row
+// to be selected, keyed by field name. Nil values match NULL.
+type Criteria map[string]any
+
+// Order represents the ordering of selected rows by the column mapped to a field.
+type Order struct {
+	Field      string
+	Descending bool
+}
+
+// Page represents a range of selected rows. A zero Limit selects every row after the
+// Offset, although some databases, such as MySQL and SQLite, require a Limit when an
+// Offset is provided.
+type Page struct {
+	Limit  int
+	Offset int
+}
+
 // QueryOptions represents the options available for generating a query.
 type QueryOptions struct {
-	Placeholder string
-	Ordered     bool
-	Named       bool
-	OmitEmpty   bool
-	obj         any
+	Placeholder    string
+	Ordered        bool
+	Named          bool
+	OmitEmpty      bool
+	Empty          EmptyPredicate
+	GroupBy        []string
+	ExpandTuples   bool
+	OffsetFetch    bool
+	UnqualifiedSet bool
+	Lock           LockMode
+	LockWait       LockWaitPolicy
+	TableHints     []string
+	ctx            context.Context
+	obj            any
+	aggregate      AggregateFunction
+	field          string
+	keys           int
+	rows           int
+	columns        []string
+	criteria       Criteria
+	order          []Order
+	page           Page
+	substituted    bool
+	returning      string
 }
 
 // QueryOption represents a function that modifies the query options.
@@ -23,6 +131,16 @@ type QueryOption func(*QueryOptions)
 // DefaultQueryOptions represents the default query options used for query generation.
 var DefaultQueryOptions = []QueryOption{WithDefaultPlaceholder()}
 
+// Dialects are the query options that adapt the generated queries to each supported
+// SQL dialect, keyed by the dialect name. Additional dialects can be registered by
+// adding to the map.
+var Dialects = map[string][]QueryOption{
+	"postgres":  {WithPlaceholder("$", true), WithUnqualifiedSet()},
+	"mysql":     {WithDefaultPlaceholder()},
+	"sqlite":    {WithDefaultPlaceholder(), WithUnqualifiedSet()},
+	"sqlserver": {WithPlaceholder("@p", true), WithExpandedTuples(), WithOffsetFetch()},
+}
+
 // WithPlaceholder sets the placeholder value and whether the parameter should have
 // a sequence number appended to it.
 func WithPlaceholder(p string, o bool) QueryOption {
@@ -46,7 +164,107 @@ func WithNamedParameters() QueryOption {
 	}
 }
 
-// WithoutEmptyValues indicates that columns with no value should be omitted from the query.
+// withoutNamedParameters sets the query to use placeholders instead of named parameters.
+func withoutNamedParameters() QueryOption {
+	return func(q *QueryOptions) {
+		q.Named = false
+	}
+}
+
+// withSubstitutedParameters indicates that the named parameters of the query will be
+// substituted with placeholders, so the AfterQuery hooks are run after the substitution
+// instead of after the query is rendered.
+func withSubstitutedParameters() QueryOption {
+	return func(q *QueryOptions) {
+		q.Named = true
+		q.substituted = true
+	}
+}
+
+// WithExpandedTuples indicates that composite primary keys should be matched using OR-ed
+// conjunctions instead of tuple comparisons, for databases that do not support row values.
+func WithExpandedTuples() QueryOption {
+	return func(q *QueryOptions) {
+		q.ExpandTuples = true
+	}
+}
+
+// WithUnqualifiedSet indicates that the columns set by an UPDATE query should not be qualified
+// by the table alias, for databases such as Postgres and SQLite that reject qualified columns.
+func WithUnqualifiedSet() QueryOption {
+	return func(q *QueryOptions) {
+		q.UnqualifiedSet = true
+	}
+}
+
+// WithOffsetFetch indicates that selected rows should be paginated using OFFSET and FETCH
+// clauses instead of LIMIT and OFFSET, for databases such as SQL Server.
+func WithOffsetFetch() QueryOption {
+	return func(q *QueryOptions) {
+		q.OffsetFetch = true
+	}
+}
+
+// WithForUpdate locks the rows retrieved by a SELECT query for updating.
+func WithForUpdate() QueryOption {
+	return func(q *QueryOptions) {
+		q.Lock = LockForUpdate
+	}
+}
+
+// WithForShare locks the rows retrieved by a SELECT query for reading.
+func WithForShare() QueryOption {
+	return func(q *QueryOptions) {
+		q.Lock = LockForShare
+	}
+}
+
+// WithNoWait indicates that a locking SELECT query should fail instead of waiting
+// for rows locked by another transaction. It has no effect without a locking clause.
+func WithNoWait() QueryOption {
+	return func(q *QueryOptions) {
+		q.LockWait = LockNoWait
+	}
+}
+
+// WithSkipLocked indicates that a locking SELECT query should omit rows locked by
+// another transaction. It has no effect without a locking clause.
+func WithSkipLocked() QueryOption {
+	return func(q *QueryOptions) {
+		q.LockWait = LockSkipLocked
+	}
+}
+
+// WithTableHints sets the table hints rendered after the table in a SELECT query,
+// such as those supported by SQL Server.
+func WithTableHints(hints ...string) QueryOption {
+	return func(q *QueryOptions) {
+		q.TableHints = append([]string{}, hints...)
+	}
+}
+
+// WithUpdateLockHints sets the SQL Server table hints that lock the rows retrieved
+// by a SELECT query for updating.
+func WithUpdateLockHints() QueryOption {
+	return WithTableHints("UPDLOCK", "ROWLOCK")
+}
+
+// withColumns restricts the non-primary key columns set by an UPDATE query to the provided column names.
+func withColumns(names ...string) QueryOption {
+	return func(q *QueryOptions) {
+		q.columns = append([]string{}, names...)
+	}
+}
+
+// withKeys sets the number of primary keys a query by keys is generated for.
+func withKeys(count int) QueryOption {
+	return func(q *QueryOptions) {
+		q.keys = count
+	}
+}
+
+// WithoutEmptyValues indicates that columns with no value for the provided object should be
+// omitted from INSERT and UPDATE queries. By default, only nil values are considered empty.
 func WithoutEmptyValues(obj any) QueryOption {
 	return func(q *QueryOptions) {
 		q.OmitEmpty = true
@@ -54,35 +272,131 @@ func WithoutEmptyValues(obj any) QueryOption {
 	}
 }
 
+// WithEmptyPredicate sets the predicate used by WithoutEmptyValues to determine if a value is empty.
+func WithEmptyPredicate(p EmptyPredicate) QueryOption {
+	return func(q *QueryOptions) {
+		q.Empty = p
+	}
+}
+
+// WithoutZeroValues indicates that columns with nil or zero values for the provided object
+// should be omitted from INSERT and UPDATE queries.
+func WithoutZeroValues(obj any) QueryOption {
+	return func(q *QueryOptions) {
+		WithoutEmptyValues(obj)(q)
+		WithEmptyPredicate(EmptyIfZero)(q)
+	}
+}
+
+// WithGroupBy groups the results of an aggregate query by the provided field names.
+func WithGroupBy(fields ...string) QueryOption {
+	return func(q *QueryOptions) {
+		q.GroupBy = append([]string{}, fields...)
+	}
+}
+
+// WithContext provides the context of the operation to the hooks run when generating queries.
+func WithContext(ctx context.Context) QueryOption {
+	return func(q *QueryOptions) {
+		q.ctx = ctx
+	}
+}
+
+// newQueryOptions applies the provided options to new query options.
+func newQueryOptions(options ...QueryOption) *QueryOptions {
+	qo := &QueryOptions{}
+	for _, opt := range options {
+		opt(qo)
+	}
+	return qo
+}
+
+// context retrieves the context provided using WithContext, or the background context.
+func (q *QueryOptions) context() context.Context {
+	if q.ctx == nil {
+		return context.Background()
+	}
+	return q.ctx
+}
+
+// withReturning sets the column returned by an INSERT query.
+func withReturning(column string) QueryOption {
+	return func(q *QueryOptions) {
+		q.returning = column
+	}
+}
+
+// withRows sets the number of rows an INSERT query for many rows is generated for.
+func withRows(count int) QueryOption {
+	return func(q *QueryOptions) {
+		q.rows = count
+	}
+}
+
+// withWhere sets the criteria, ordering and page of the rows selected by a query.
+func withWhere(criteria Criteria, order []Order, page Page) QueryOption {
+	return func(q *QueryOptions) {
+		q.criteria = criteria
+		q.order = append([]Order{}, order...)
+		q.page = page
+	}
+}
+
+// withAggregate sets the aggregate function and the field it is applied to.
+func withAggregate(fn AggregateFunction, field string) QueryOption {
+	return func(q *QueryOptions) {
+		q.aggregate = fn
+		q.field = field
+	}
+}
+
 // insertSQL is the raw template contents used to generate an insert query.
 const insertSQL = `
   {{- $table := .Table -}}
   {{- $options := .Options -}}
   {{- $seq := 0 -}}
+  {{- $count := 0 -}}
+  {{- $omitted := .Omitted -}}
+  {{- required $table.Columns $omitted -}}
   INSERT INTO {{$table.Name}} (
   {{- range $idx, $col := $table.Columns -}}
-    {{$col.Name}}{{if ne $idx (sub (len $table.Columns) 1)}}, {{end}}
+    {{- if omit $omitted $col.Name -}} {{continue}} {{- end -}}
+    {{- if ne $count 0 -}} , {{end}}
+    {{- $count = add $count 1 -}}
+    {{$col.Name}}
   {{- end -}}
   ) VALUES (
   {{- range $idx, $col := $table.Columns -}}
+    {{- if omit $omitted $col.Name -}} {{continue}} {{- end -}}
+    {{- if ne $seq 0 -}} , {{end}}
     {{- $seq = add $seq 1 -}}
-    {{param $col.Name $options $seq}}{{if ne $idx (sub (len $table.Columns) 1)}}, {{end}}
+    {{param $col.Name $options $seq}}
   {{- end -}}
-  );`
+  ){{returning $options}};`
+
+// insertRowsSQL is the raw template contents used to generate an insert query for many rows.
+const insertRowsSQL = `
+  {{- $table := .Table -}}
+  INSERT INTO {{$table.Name}} (
+  {{- range $idx, $col := $table.Columns -}}
+    {{- if ne $idx 0}}, {{end}}{{$col.Name}}
+  {{- end -}}
+  ) VALUES {{rows $table.Columns .Options}};`
 
 // updateSQL is the raw template contents used to generate an update query.
 const updateSQL = `
   {{- $table := .Table -}}
   {{- $options := .Options -}}
   {{- $seq := 0 -}}
-  {{- $data := .Data -}}
+  {{- $omitted := .Omitted -}}
   {{- $nonPrimaryKeys := .NonPrimaryKeys -}}
+  {{- required $nonPrimaryKeys $omitted -}}
   UPDATE {{$table.Name}} AS {{$table.Alias}} SET {{- if true}} {{end}}
   {{- range $idx, $col := $nonPrimaryKeys -}}
-    {{- if omit $data $col.Name -}} {{continue}} {{- end -}}
-    {{- if ne $idx 0 -}} , {{end}}
+    {{- if omit $omitted $col.Name -}} {{continue}} {{- end -}}
+    {{- if ne $seq 0 -}} , {{end}}
     {{- $seq = add $seq 1 -}}
-    {{$table.Alias}}.{{.Name}} = {{param $col.Name $options $seq}}
+    {{if not $options.UnqualifiedSet}}{{$table.Alias}}.{{end}}{{.Name}} = {{param $col.Name $options $seq}}
   {{- end }} WHERE 1=1
   {{- range $idx, $col := .PrimaryKeys -}}
     {{- $seq = add $seq 1 }} AND {{$table.Alias}}.{{.Name}} = {{param $col.Name $options $seq}}
@@ -106,9 +420,64 @@ const selectSQL = `
   {{- range $idx, $col := $table.Columns -}}
     {{$table.Alias}}.{{$col.Name}}{{if ne $idx (sub (len $table.Columns) 1)}}, {{end}}
   {{- end -}}
-  {{- if true}} {{end -}} FROM {{$table.Name}} AS {{$table.Alias}} WHERE 1=1
+  {{- if true}} {{end -}} FROM {{$table.Name}} AS {{$table.Alias}}{{hints $options}} WHERE 1=1
   {{- range $idx, $col := .PrimaryKeys -}}
     {{- $seq = add $seq 1 }} AND {{$table.Alias}}.{{.Name}} = {{param $col.Name $options $seq}}
+  {{- end -}}{{lock $options}};`
+
+// selectByKeysSQL is the raw template contents used to generate a select query for many keys.
+const selectByKeysSQL = `
+  {{- $table := .Table -}}
+  SELECT {{- if true}} {{end}}
+  {{- range $idx, $col := $table.Columns -}}
+    {{$table.Alias}}.{{$col.Name}}{{if ne $idx (sub (len $table.Columns) 1)}}, {{end}}
+  {{- end -}}
+  {{- if true}} {{end -}} FROM {{$table.Name}} AS {{$table.Alias}}{{hints .Options}} WHERE {{keys .PrimaryKeys .Options (printf "%s." $table.Alias)}}{{lock .Options}};`
+
+// deleteByKeysSQL is the raw template contents used to generate a delete query for many keys.
+const deleteByKeysSQL = `
+  {{- $table := .Table -}}
+  DELETE FROM {{$table.Name}} WHERE {{keys .PrimaryKeys .Options ""}};`
+
+// selectWhereSQL is the raw template contents used to generate a select query for criteria.
+const selectWhereSQL = `
+  {{- $table := .Table -}}
+  {{- $options := .Options -}}
+  {{- $seq := 0 -}}
+  SELECT {{- if true}} {{end}}
+  {{- range $idx, $col := $table.Columns -}}
+    {{$table.Alias}}.{{$col.Name}}{{if ne $idx (sub (len $table.Columns) 1)}}, {{end}}
+  {{- end -}}
+  {{- if true}} {{end -}} FROM {{$table.Name}} AS {{$table.Alias}}{{hints $options}} WHERE 1=1
+  {{- range .Where -}}
+    {{- if .Null}} AND {{$table.Alias}}.{{.Name}} IS NULL
+    {{- else}}{{$seq = add $seq 1}} AND {{$table.Alias}}.{{.Name}} = {{param .Name $options $seq}}{{end}}
+  {{- end -}}
+  {{- paginate .OrderBy $table.Alias $options -}}{{lock $options}};`
+
+// existsSQL is the raw template contents used to generate an exists query.
+const existsSQL = `
+  {{- $table := .Table -}}
+  {{- $options := .Options -}}
+  {{- $seq := 0 -}}
+  SELECT EXISTS (SELECT 1 FROM {{$table.Name}} AS {{$table.Alias}} WHERE 1=1
+  {{- range $idx, $col := .PrimaryKeys -}}
+    {{- $seq = add $seq 1 }} AND {{$table.Alias}}.{{.Name}} = {{param $col.Name $options $seq}}
+  {{- end -}});`
+
+// aggregateSQL is the raw template contents used to generate an aggregate query.
+const aggregateSQL = `
+  {{- $table := .Table -}}
+  {{- $aggregate := .Aggregate -}}
+  SELECT {{- if true}} {{end}}
+  {{- range $idx, $col := .GroupBy -}}
+    {{$table.Alias}}.{{$col.Name}}, {{end -}}
+  {{$aggregate.Function}}(
+  {{- if $aggregate.Column}}{{$table.Alias}}.{{$aggregate.Column.Name}}{{else}}*{{end -}}
+  ) FROM {{$table.Name}} AS {{$table.Alias}}
+  {{- if .GroupBy}} GROUP BY {{end -}}
+  {{- range $idx, $col := .GroupBy -}}
+    {{$table.Alias}}.{{$col.Name}}{{if ne $idx (sub (len $.GroupBy) 1)}}, {{end}}
   {{- end -}};`
 
 var (
@@ -126,14 +495,40 @@ var (
 
 			return p
 		},
-		"omit": func(data EvaluationResult, columnName string) bool {
-			for _, col := range data.Empties() {
-				if col == columnName {
-					return true
+		"keys":     keys,
+		"paginate": paginate,
+		"rows":     rows,
+		"hints": func(options *QueryOptions) string {
+			if len(options.TableHints) == 0 {
+				return ""
+			}
+			return " WITH (" + strings.Join(options.TableHints, ", ") + ")"
+		},
+		"returning": func(options *QueryOptions) string {
+			if options.returning == "" {
+				return ""
+			}
+			return " RETURNING " + options.returning
+		},
+		"lock": func(options *QueryOptions) string {
+			if options.Lock == "" {
+				return ""
+			}
+			if options.LockWait == "" {
+				return " " + string(options.Lock)
+			}
+			return " " + string(options.Lock) + " " + string(options.LockWait)
+		},
+		"omit": func(omitted map[string]bool, columnName string) bool {
+			return omitted[columnName]
+		},
+		"required": func(columns []Column, omitted map[string]bool) (string, error) {
+			for _, col := range columns {
+				if !omitted[col.Name()] {
+					return "", nil
 				}
 			}
-
-			return false
+			return "", ErrAllColumnsOmitted
 		},
 		"sub": func(a, b int) int {
 			return a - b
@@ -146,6 +541,9 @@ var (
 	// insertTmpl is the parsed template used to generate an INSERT query.
 	insertTmpl = template.Must(template.New("insertQuery").Funcs(funcs).Parse(insertSQL))
 
+	// insertRowsTmpl is the parsed template used to generate an INSERT query for many rows.
+	insertRowsTmpl = template.Must(template.New("insertRowsQuery").Funcs(funcs).Parse(insertRowsSQL))
+
 	// updateTmpl is the parsed template used to generate an UPDATE query.
 	updateTmpl = template.Must(template.New("updateQuery").Funcs(funcs).Parse(updateSQL))
 
@@ -154,4 +552,149 @@ var (
 
 	// selectTmpl is the parsed template used to generate a SELECT query.
 	selectTmpl = template.Must(template.New("selectQuery").Funcs(funcs).Parse(selectSQL))
+
+	// selectWhereTmpl is the parsed template used to generate a SELECT query for criteria.
+	selectWhereTmpl = template.Must(template.New("selectWhereQuery").Funcs(funcs).Parse(selectWhereSQL))
+
+	// selectByKeysTmpl is the parsed template used to generate a SELECT query for many keys.
+	selectByKeysTmpl = template.Must(template.New("selectByKeysQuery").Funcs(funcs).Parse(selectByKeysSQL))
+
+	// deleteByKeysTmpl is the parsed template used to generate a DELETE query for many keys.
+	deleteByKeysTmpl = template.Must(template.New("deleteByKeysQuery").Funcs(funcs).Parse(deleteByKeysSQL))
+
+	// existsTmpl is the parsed template used to generate an EXISTS query.
+	existsTmpl = template.Must(template.New("existsQuery").Funcs(funcs).Parse(existsSQL))
+
+	// aggregateTmpl is the parsed template used to generate an aggregate query.
+	aggregateTmpl = template.Must(template.New("aggregateQuery").Funcs(funcs).Parse(aggregateSQL))
 )
+
+// keys renders the predicate matching the primary key columns against the number of
+// keys configured in the provided options. Single column keys are matched using IN,
+// while composite keys are matched using tuple comparisons or, when expanded, OR-ed
+// conjunctions.
+func keys(primaryKeys []Column, options *QueryOptions, prefix string) string {
+	seq := 0
+	param := func(col Column, key int) string {
+		seq++
+		if options.Named {
+			return fmt.Sprintf(":%s_%d", col.Name(), key)
+		}
+		p := options.Placeholder
+		if options.Ordered {
+			p += strconv.Itoa(seq)
+		}
+		return p
+	}
+
+	names := make([]string, len(primaryKeys))
+	for idx, col := range primaryKeys {
+		names[idx] = prefix + col.Name()
+	}
+
+	if len(primaryKeys) > 1 && options.ExpandTuples {
+		conjunctions := make([]string, options.keys)
+		for key := range conjunctions {
+			conditions := make([]string, len(primaryKeys))
+			for idx, col := range primaryKeys {
+				conditions[idx] = names[idx] + " = " + param(col, key)
+			}
+			conjunctions[key] = "(" + strings.Join(conditions, " AND ") + ")"
+		}
+		return strings.Join(conjunctions, " OR ")
+	}
+
+	tuples := make([]string, options.keys)
+	for key := range tuples {
+		params := make([]string, len(primaryKeys))
+		for idx, col := range primaryKeys {
+			params[idx] = param(col, key)
+		}
+		tuples[key] = strings.Join(params, ", ")
+		if len(primaryKeys) > 1 {
+			tuples[key] = "(" + tuples[key] + ")"
+		}
+	}
+
+	if len(primaryKeys) > 1 {
+		return "(" + strings.Join(names, ", ") + ") IN (" + strings.Join(tuples, ", ") + ")"
+	}
+	return names[0] + " IN (" + strings.Join(tuples, ", ") + ")"
+}
+
+// rows renders the values of the number of rows configured in the provided options, with
+// a parameter for each of the provided columns. Named parameters are suffixed by the index
+// of the row.
+func rows(columns []Column, options *QueryOptions) string {
+	seq := 0
+	tuples := make([]string, options.rows)
+	for row := range tuples {
+		params := make([]string, len(columns))
+		for idx, col := range columns {
+			seq++
+			switch {
+			case options.Named:
+				params[idx] = fmt.Sprintf(":%s_%d", col.Name(), row)
+			case options.Ordered:
+				params[idx] = options.Placeholder + strconv.Itoa(seq)
+			default:
+				params[idx] = options.Placeholder
+			}
+		}
+		tuples[row] = "(" + strings.Join(params, ", ") + ")"
+	}
+	return strings.Join(tuples, ", ")
+}
+
+// condition represents the comparison of a column within the criteria of a query.
+type condition struct {
+	Name  string
+	Value any
+	Null  bool
+}
+
+// ordering represents the ordering of selected rows by a column.
+type ordering struct {
+	Name       string
+	Descending bool
+}
+
+// paginate renders the ORDER BY clause for the provided orderings, followed by the clauses
+// selecting the page configured in the provided options. SQL Server requires an ORDER BY
+// clause to paginate, so the rows are ordered arbitrarily when no orderings are provided.
+func paginate(orderings []ordering, alias string, options *QueryOptions) string {
+	var b strings.Builder
+	for idx, o := range orderings {
+		if idx == 0 {
+			b.WriteString(" ORDER BY ")
+		} else {
+			b.WriteString(", ")
+		}
+		b.WriteString(alias + "." + o.Name)
+		if o.Descending {
+			b.WriteString(" DESC")
+		}
+	}
+
+	page := options.page
+	if page.Limit <= 0 && page.Offset <= 0 {
+		return b.String()
+	}
+	if options.OffsetFetch {
+		if len(orderings) == 0 {
+			b.WriteString(" ORDER BY (SELECT NULL)")
+		}
+		fmt.Fprintf(&b, " OFFSET %d ROWS", max(page.Offset, 0))
+		if page.Limit > 0 {
+			fmt.Fprintf(&b, " FETCH NEXT %d ROWS ONLY", page.Limit)
+		}
+		return b.String()
+	}
+	if page.Limit > 0 {
+		fmt.Fprintf(&b, " LIMIT %d", page.Limit)
+	}
+	if page.Offset > 0 {
+		fmt.Fprintf(&b, " OFFSET %d", page.Offset)
+	}
+	return b.String()
+}
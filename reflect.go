@@ -2,6 +2,7 @@ package morph
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
 	"regexp"
 	"slices"
@@ -35,47 +36,12 @@ func Reflect(obj any, options ...ReflectOption) (Table, error) {
 	}
 	pt := reflect.PointerTo(t)
 
-	configuration := ReflectConfiguration{}
-	opts := append(DefaultReflectOptions, options...)
-	for _, opt := range opts {
-		opt(&configuration)
-	}
-
-	tableName := configuration.TableName
-	if configuration.IsInferredTableName {
-		if configuration.SnakeCaseTableName() {
-			tName := strcase.ToSnake(t.Name())
-			tableName = &tName
-		}
-
-		if configuration.ScreamingSnakeCaseTableName() {
-			tName := strings.ToUpper(strcase.ToSnake(t.Name()))
-			tableName = &tName
-		}
-
-		if configuration.CamelCaseTableName() {
-			tName := strcase.ToCamel(t.Name())
-			tableName = &tName
-		}
-
-		if configuration.PluralTableName() {
-			tName := pluralize.NewClient().Plural(*tableName)
-			tableName = &tName
-		}
-	}
-
-	tableAlias := configuration.TableAlias
-	if configuration.IsInferredTableAlias && configuration.HasTableAliasLength() {
-		if configuration.UppercaseTableAlias() {
-			a := strings.ToUpper((*tableName)[:*configuration.TableAliasLength])
-			tableAlias = &a
-		}
-
-		if configuration.LowercaseTableAlias() {
-			a := strings.ToLower((*tableName)[:*configuration.TableAliasLength])
-			tableAlias = &a
-		}
+	configuration, err := NewReflectConfiguration(options...)
+	if err != nil {
+		return Table{}, err
 	}
+	tableName := configuration.TableNameFor(t.Name())
+	tableAlias := configuration.TableAliasFor(tableName)
 
 	columns := []Column{}
 	columns = append(columns, fields(t, val, configuration)...)
@@ -83,8 +49,8 @@ func Reflect(obj any, options ...ReflectOption) (Table, error) {
 
 	table := Table{}
 	table.SetType(obj)
-	table.SetName(*tableName)
-	table.SetAlias(*tableAlias)
+	table.SetName(tableName)
+	table.SetAlias(tableAlias)
 	if err := table.AddColumns(columns...); err != nil {
 		return Table{}, err
 	}
@@ -114,60 +80,15 @@ func fields(t reflect.Type, v reflect.Value, c ReflectConfiguration) []Column {
 			continue
 		}
 
-		var tagValue string
-		if c.HasTag() {
-			tagValue = strings.TrimSpace(field.Tag.Get(*c.Tag))
-			if tagValue == "-" {
-				continue
-			}
-		}
-
-		if c.HasFieldExclusionPattern() {
-			if regexp.MustCompile(*c.FieldExclusionPattern).MatchString(fieldName) {
-				continue
-			}
-		}
-
-		if c.HasFieldExclusions() && slices.Contains(c.FieldExclusions, field.Name) {
+		columnName, ok := c.FieldColumnName(fieldName, field.Tag)
+		if !ok {
 			continue
 		}
 
-		columnName := fieldName
-		if tagValue != "" {
-			columnName = tagValue
-		} else if c.IsInferredColumnNames {
-			if c.SnakeCaseColumnName() {
-				columnName = strcase.ToSnake(columnName)
-			}
-
-			if c.ScreamingSnakeCaseColumnName() {
-				columnName = strings.ToUpper(strcase.ToSnake(columnName))
-			}
-
-			if c.CamelCaseColumnName() {
-				columnName = strcase.ToCamel(columnName)
-			}
-
-			if c.UppercaseColumnName() {
-				columnName = strings.ToUpper(columnName)
-			}
-
-			if c.LowercaseColumnName() {
-				columnName = strings.ToLower(columnName)
-			}
-		}
-
 		var column Column
 		column.SetField(fieldName)
-		if c.HasColumnNameMappings() {
-			if cName, ok := c.ColumnNameMappings[fieldName]; ok {
-				columnName = cName
-			}
-		}
 		column.SetName(columnName)
-		if slices.Contains(c.PrimaryKeyColumns, columnName) {
-			column.SetPrimaryKey(true)
-		}
+		column.SetPrimaryKey(c.IsPrimaryKeyColumn(columnName))
 		column.SetFieldType(fieldType)
 		column.SetStrategy(FieldStrategyStructField)
 		columns = append(columns, column)
@@ -197,53 +118,194 @@ func methods(t reflect.Type, c ReflectConfiguration) []Column {
 		}
 		fieldType := method.Type.Out(0).String()
 
-		if c.HasMethodExclusionPattern() {
-			if regexp.MustCompile(*c.MethodExclusionPattern).MatchString(fieldName) {
-				continue
-			}
+		columnName, ok := c.MethodColumnName(fieldName)
+		if !ok {
+			continue
 		}
 
-		if c.HasMethodExclusions() && slices.Contains(c.MethodExclusions, fieldName) {
-			continue
+		var column Column
+		column.SetField(fieldName)
+		column.SetName(columnName)
+		column.SetPrimaryKey(c.IsPrimaryKeyColumn(columnName))
+		column.SetFieldType(fieldType)
+		column.SetStrategy(FieldStrategyMethod)
+		columns = append(columns, column)
+	}
+	return columns
+}
+
+// NewReflectConfiguration constructs the reflection configuration by applying the
+// default reflection options followed by the provided options, failing when the
+// method or field exclusion patterns are not valid regular expressions.
+func NewReflectConfiguration(options ...ReflectOption) (ReflectConfiguration, error) {
+	configuration := ReflectConfiguration{}
+	for _, opt := range DefaultReflectOptions {
+		opt(&configuration)
+	}
+	for _, opt := range options {
+		opt(&configuration)
+	}
+
+	var err error
+	if configuration.HasMethodExclusionPattern() {
+		configuration.methodExclusion, err = regexp.Compile(*configuration.MethodExclusionPattern)
+		if err != nil {
+			return ReflectConfiguration{}, fmt.Errorf("morph: invalid method exclusion pattern %q: %w", *configuration.MethodExclusionPattern, err)
 		}
+	}
+	if configuration.HasFieldExclusionPattern() {
+		configuration.fieldExclusion, err = regexp.Compile(*configuration.FieldExclusionPattern)
+		if err != nil {
+			return ReflectConfiguration{}, fmt.Errorf("morph: invalid field exclusion pattern %q: %w", *configuration.FieldExclusionPattern, err)
+		}
+	}
+	return configuration, nil
+}
 
-		columnName := fieldName
-		if c.IsInferredColumnNames {
-			if c.SnakeCaseColumnName() {
-				columnName = strcase.ToSnake(columnName)
-			}
+// TableNameFor retrieves the table name for the type with the provided name.
+func (c *ReflectConfiguration) TableNameFor(typeName string) string {
+	var tableName string
+	if c.TableName != nil {
+		tableName = *c.TableName
+	}
 
-			if c.ScreamingSnakeCaseColumnName() {
-				columnName = strings.ToUpper(strcase.ToSnake(columnName))
-			}
+	if c.IsInferredTableName {
+		if c.SnakeCaseTableName() {
+			tableName = strcase.ToSnake(typeName)
+		}
 
-			if c.CamelCaseColumnName() {
-				columnName = strcase.ToCamel(columnName)
-			}
+		if c.ScreamingSnakeCaseTableName() {
+			tableName = strings.ToUpper(strcase.ToSnake(typeName))
+		}
 
-			if c.UppercaseColumnName() {
-				columnName = strings.ToUpper(columnName)
-			}
+		if c.CamelCaseTableName() {
+			tableName = strcase.ToCamel(typeName)
+		}
 
-			if c.LowercaseColumnName() {
-				columnName = strings.ToLower(columnName)
-			}
+		if c.PluralTableName() {
+			tableName = pluralize.NewClient().Plural(tableName)
 		}
+	}
+	return tableName
+}
 
-		var column Column
-		column.SetField(fieldName)
-		if c.HasColumnNameMappings() {
-			if cName, ok := c.ColumnNameMappings[fieldName]; ok {
-				columnName = cName
-			}
+// TableAliasFor retrieves the table alias for the table with the provided name.
+func (c *ReflectConfiguration) TableAliasFor(tableName string) string {
+	var tableAlias string
+	if c.TableAlias != nil {
+		tableAlias = *c.TableAlias
+	}
+
+	if c.IsInferredTableAlias && c.HasTableAliasLength() {
+		prefix := tableName
+		if *c.TableAliasLength < len(prefix) {
+			prefix = prefix[:*c.TableAliasLength]
 		}
-		column.SetName(columnName)
-		if slices.Contains(c.PrimaryKeyColumns, columnName) {
-			column.SetPrimaryKey(true)
+
+		if c.UppercaseTableAlias() {
+			tableAlias = strings.ToUpper(prefix)
+		}
+
+		if c.LowercaseTableAlias() {
+			tableAlias = strings.ToLower(prefix)
 		}
-		column.SetFieldType(fieldType)
-		column.SetStrategy(FieldStrategyMethod)
-		columns = append(columns, column)
 	}
-	return columns
+	return tableAlias
+}
+
+// FieldColumnName retrieves the column name for the struct field with the provided name
+// and tag, and indicates if the field is included in reflection.
+func (c *ReflectConfiguration) FieldColumnName(field string, tag reflect.StructTag) (string, bool) {
+	var tagValue string
+	if c.HasTag() {
+		tagValue = strings.TrimSpace(tag.Get(*c.Tag))
+		if tagValue == "-" {
+			return "", false
+		}
+	}
+
+	if excluded(c.fieldExclusion, c.FieldExclusionPattern, field) {
+		return "", false
+	}
+
+	if c.HasFieldExclusions() && slices.Contains(c.FieldExclusions, field) {
+		return "", false
+	}
+
+	columnName := tagValue
+	if columnName == "" {
+		columnName = c.inferColumnName(field)
+	}
+	return c.mappedColumnName(field, columnName), true
+}
+
+// MethodColumnName retrieves the column name for the method with the provided name,
+// and indicates if the method is included in reflection.
+func (c *ReflectConfiguration) MethodColumnName(method string) (string, bool) {
+	if excluded(c.methodExclusion, c.MethodExclusionPattern, method) {
+		return "", false
+	}
+
+	if c.HasMethodExclusions() && slices.Contains(c.MethodExclusions, method) {
+		return "", false
+	}
+
+	return c.mappedColumnName(method, c.inferColumnName(method)), true
+}
+
+// excluded indicates if the provided name matches an exclusion pattern, using the provided
+// compiled expression when the configuration was constructed by NewReflectConfiguration.
+// Otherwise, the pattern is compiled, and patterns that are not valid exclude nothing.
+func excluded(compiled *regexp.Regexp, pattern *string, name string) bool {
+	if compiled != nil {
+		return compiled.MatchString(name)
+	}
+	if pattern == nil || strings.TrimSpace(*pattern) == "" {
+		return false
+	}
+	matched, err := regexp.MatchString(*pattern, name)
+	return err == nil && matched
+}
+
+// IsPrimaryKeyColumn indicates if the column with the provided name is a primary key.
+func (c *ReflectConfiguration) IsPrimaryKeyColumn(name string) bool {
+	return slices.Contains(c.PrimaryKeyColumns, name)
+}
+
+// inferColumnName infers the column name from the provided field or method name.
+func (c *ReflectConfiguration) inferColumnName(name string) string {
+	columnName := name
+	if c.IsInferredColumnNames {
+		if c.SnakeCaseColumnName() {
+			columnName = strcase.ToSnake(columnName)
+		}
+
+		if c.ScreamingSnakeCaseColumnName() {
+			columnName = strings.ToUpper(strcase.ToSnake(columnName))
+		}
+
+		if c.CamelCaseColumnName() {
+			columnName = strcase.ToCamel(columnName)
+		}
+
+		if c.UppercaseColumnName() {
+			columnName = strings.ToUpper(columnName)
+		}
+
+		if c.LowercaseColumnName() {
+			columnName = strings.ToLower(columnName)
+		}
+	}
+	return columnName
+}
+
+// mappedColumnName retrieves the explicitly mapped column name for the provided field
+// or method name, if any, and otherwise the provided column name.
+func (c *ReflectConfiguration) mappedColumnName(name, columnName string) string {
+	if c.HasColumnNameMappings() {
+		if cName, ok := c.ColumnNameMappings[name]; ok {
+			return cName
+		}
+	}
+	return columnName
 }
@@ -1201,6 +1201,16 @@ func (s *ReflectTestSuite) TestReflect_WithValue() {
 	}
 }
 
+func (s *ReflectTestSuite) TestReflect_InvalidExclusionPattern() {
+	// action.
+	_, methodErr := morph.Reflect(s.obj, morph.WithoutMatchingMethods("("))
+	_, fieldErr := morph.Reflect(s.obj, morph.WithoutMatchingFields("["))
+
+	// assert.
+	s.EqualError(methodErr, "morph: invalid method exclusion pattern \"(\": error parsing regexp: missing closing ): `(`")
+	s.EqualError(fieldErr, "morph: invalid field exclusion pattern \"[\": error parsing regexp: missing closing ]: `[`")
+}
+
 func (s *ReflectTestSuite) TestReflect_WithPointer() {
 	notStruct := 2
 
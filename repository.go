@@ -0,0 +1,192 @@
+package morph
+
+import (
+	"context"
+	"errors"
+)
+
+// Repository retrieves and persists the entities of type T, which is a struct type mapped
+// by the table of the repository, using an executor.
+type Repository[T any] struct {
+	table    Table
+	executor *Executor
+}
+
+// NewRepository constructs a repository for the entities of type T mapped by the provided
+// table, which executes queries using the provided executor. It fails when the table does
+// not map T or is not properly configured.
+func NewRepository[T any](e *Executor, t Table) (*Repository[T], error) {
+	if !t.matchesType(new(T)) {
+		return nil, ErrMismatchingTypeName
+	}
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+	return &Repository[T]{table: t, executor: e}, nil
+}
+
+// ReflectRepository constructs a repository for the entities of type T mapped by the table
+// reflected from T using the provided options. See NewRepository for more information.
+func ReflectRepository[T any](e *Executor, options ...ReflectOption) (*Repository[T], error) {
+	var entity T
+	t, err := Reflect(entity, options...)
+	if err != nil {
+		return nil, err
+	}
+	return NewRepository[T](e, t)
+}
+
+// Table retrieves the table mapping the entities of the repository.
+func (r *Repository[T]) Table() Table {
+	return r.table
+}
+
+// Find retrieves the entity with the provided key, failing with ErrNotFound when there is
+// none. The key is either an entity, a single value for tables with one primary key column,
+// or a []any containing a value for each primary key column ordered by column name. When
+// the context carries an identity map, the entity kept for the key is retrieved without
+// querying, and the entity loaded is otherwise kept.
+func (r *Repository[T]) Find(ctx context.Context, key any) (*T, error) {
+	if m, ok := IdentityMapFromContext(ctx); ok {
+		kept, found, err := m.Get(r.table, key)
+		if err != nil {
+			return nil, err
+		}
+		if entity, ok := kept.(*T); found && ok {
+			return entity, nil
+		}
+	}
+
+	args, err := r.keyArgs(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	opts := append(r.executor.queryOptions(ctx), withoutNamedParameters())
+	query, err := r.table.SelectQuery(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if query, args, err = r.table.withArgs(selectTmpl, query, args, opts...); err != nil {
+		return nil, err
+	}
+
+	entity := new(T)
+	if err := r.executor.queryRow(ctx, r.table, entity, query, args); err != nil {
+		return nil, err
+	}
+	return r.keep(ctx, entity)
+}
+
+// FindAll retrieves the entities matching the provided criteria, ordered by the provided
+// orders and limited to the provided page. When the context carries an identity map, the
+// entities already kept are retrieved in place of those loaded.
+func (r *Repository[T]) FindAll(ctx context.Context, criteria Criteria, order []Order, page Page) ([]*T, error) {
+	query, args, err := r.table.SelectWhereQueryWithArgs(criteria, order, page, r.executor.queryOptions(ctx)...)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.executor.q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entities := []*T{}
+	for rows.Next() {
+		entity := new(T)
+		dest, set, err := destinations(r.table, entity)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		set()
+		if entity, err = r.keep(ctx, entity); err != nil {
+			return nil, err
+		}
+		entities = append(entities, entity)
+	}
+	return entities, rows.Err()
+}
+
+// Save inserts the provided entity when any of its primary key values are empty, and updates
+// it otherwise. Entities with primary key values that have no row, such as those with natural
+// or composite keys, are inserted when the update does not affect any rows. When the context
+// carries an identity map, the entity is kept once saved, and an instance already kept for
+// its row is updated with its values.
+func (r *Repository[T]) Save(ctx context.Context, entity *T) error {
+	if err := r.save(ctx, entity); err != nil {
+		return err
+	}
+	if m, ok := IdentityMapFromContext(ctx); ok {
+		return m.refresh(r.table, entity)
+	}
+	return nil
+}
+
+// save inserts or updates the provided entity. See Save for more information.
+func (r *Repository[T]) save(ctx context.Context, entity *T) error {
+	result, err := r.table.evaluateContext(ctx, entity)
+	if err != nil {
+		return err
+	}
+	for _, col := range r.table.FindColumns(func(c Column) bool { return c.PrimaryKey() }) {
+		if EmptyIfZero(col, result[col.Name()]) {
+			return r.executor.Insert(ctx, r.table, entity)
+		}
+	}
+
+	err = r.executor.Update(ctx, r.table, entity)
+	if !errors.Is(err, ErrNoRowsAffected) {
+		return err
+	}
+
+	// some databases, such as MySQL, do not count rows that are unchanged as affected.
+	exists, err := r.Exists(ctx, entity)
+	if err != nil || exists {
+		return err
+	}
+	return r.executor.Insert(ctx, r.table, entity)
+}
+
+// Delete deletes the provided entity, failing with ErrNoRowsAffected when it has no row.
+// When the context carries an identity map, the entity is no longer kept once deleted.
+func (r *Repository[T]) Delete(ctx context.Context, entity *T) error {
+	if err := r.executor.Delete(ctx, r.table, entity); err != nil {
+		return err
+	}
+	if m, ok := IdentityMapFromContext(ctx); ok {
+		return m.Remove(r.table, entity)
+	}
+	return nil
+}
+
+// Exists indicates if there is an entity with the provided key, which is interpreted in
+// the same way as Find.
+func (r *Repository[T]) Exists(ctx context.Context, key any) (bool, error) {
+	return r.executor.exists(ctx, r.table, key)
+}
+
+// keyArgs retrieves the primary key values for the provided key in column order.
+func (r *Repository[T]) keyArgs(ctx context.Context, key any) ([]any, error) {
+	return r.table.keyValues(ctx, r.table.FindColumns(func(c Column) bool { return c.PrimaryKey() }), key)
+}
+
+// keep keeps the provided entity in the identity map carried by the provided context, if
+// any, and retrieves the instance that is kept for its row.
+func (r *Repository[T]) keep(ctx context.Context, entity *T) (*T, error) {
+	m, ok := IdentityMapFromContext(ctx)
+	if !ok {
+		return entity, nil
+	}
+	kept, err := m.Put(r.table, entity)
+	if err != nil {
+		return nil, err
+	}
+	if kept, ok := kept.(*T); ok {
+		return kept, nil
+	}
+	return entity, nil
+}
@@ -0,0 +1,327 @@
+package morph_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/freerware/morph"
+	"github.com/stretchr/testify/suite"
+	_ "modernc.org/sqlite"
+)
+
+type RepositoryTestSuite struct {
+	suite.Suite
+
+	ctx      context.Context
+	db       *sql.DB
+	executor *morph.Executor
+	accounts *morph.Repository[Account]
+	items    *morph.Repository[LineItem]
+}
+
+func TestRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RepositoryTestSuite))
+}
+
+func (s *RepositoryTestSuite) SetupTest() {
+	s.ctx = context.Background()
+
+	var err error
+	s.db, err = sql.Open("sqlite", ":memory:")
+	s.Require().NoError(err)
+	s.db.SetMaxOpenConns(1)
+	for _, statement := range []string{
+		"CREATE TABLE accounts (id INTEGER PRIMARY KEY AUTOINCREMENT, username TEXT NOT NULL, email TEXT, nickname TEXT)",
+		"CREATE TABLE line_items (order_id INTEGER NOT NULL, line INTEGER NOT NULL, quantity INTEGER NOT NULL, PRIMARY KEY (order_id, line))",
+		"INSERT INTO accounts (id, username, email, nickname) VALUES (1, 'anakin', 'ani@example.com', 'ani'), (2, 'padme', NULL, 'amidala'), (3, 'obiwan', NULL, 'ben')",
+		"INSERT INTO line_items (order_id, line, quantity) VALUES (1, 1, 5), (1, 2, 1), (2, 1, 3)",
+	} {
+		_, err := s.db.Exec(statement)
+		s.Require().NoError(err)
+	}
+
+	s.executor, err = morph.NewExecutor(s.db, morph.WithDialect("sqlite"))
+	s.Require().NoError(err)
+	tables := morph.Must(executorTables.AsMetadataStrict())
+	s.accounts, err = morph.NewRepository[Account](s.executor, tables[0])
+	s.Require().NoError(err)
+	s.items, err = morph.NewRepository[LineItem](s.executor, tables[1])
+	s.Require().NoError(err)
+}
+
+func (s *RepositoryTestSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *RepositoryTestSuite) TestNewRepository_Errors() {
+	tables := morph.Must(executorTables.AsMetadataStrict())
+
+	// action.
+	_, mismatchErr := morph.NewRepository[LineItem](s.executor, tables[0])
+	_, invalidErr := morph.NewRepository[Account](s.executor, morph.Table{})
+
+	// assert.
+	s.ErrorIs(mismatchErr, morph.ErrMismatchingTypeName)
+	s.ErrorIs(invalidErr, morph.ErrMismatchingTypeName)
+}
+
+func (s *RepositoryTestSuite) TestReflectRepository() {
+	// arrange.
+	sut, err := morph.ReflectRepository[LineItem](s.executor, morph.WithPrimaryKeyColumns("order_id", "line"))
+	s.Require().NoError(err)
+
+	// action.
+	item, err := sut.Find(s.ctx, []any{2, 1})
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal(&LineItem{OrderID: 1, Line: 2, Quantity: 1}, item)
+	table := sut.Table()
+	s.Equal("line_items", table.Name())
+}
+
+func (s *RepositoryTestSuite) TestFind() {
+	tests := []struct {
+		name string
+		key  any
+	}{
+		{name: "Value", key: 2},
+		{name: "Entity", key: &Account{ID: 2}},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// action.
+			account, err := s.accounts.Find(s.ctx, test.key)
+
+			// assert.
+			s.Require().NoError(err)
+			s.Equal(int64(2), account.ID)
+			s.Equal("padme", account.Username)
+			s.Nil(account.Email)
+			s.Equal("amidala", account.Nickname())
+		})
+	}
+}
+
+func (s *RepositoryTestSuite) TestFind_CompositeKey() {
+	// action.
+	item, err := s.items.Find(s.ctx, []any{2, 1})
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal(&LineItem{OrderID: 1, Line: 2, Quantity: 1}, item)
+}
+
+func (s *RepositoryTestSuite) TestFind_Errors() {
+	// action.
+	_, notFoundErr := s.accounts.Find(s.ctx, 404)
+	_, invalidKeyErr := s.items.Find(s.ctx, 1)
+	_, mismatchErr := s.accounts.Find(s.ctx, &LineItem{OrderID: 2, Line: 1})
+
+	// assert.
+	s.ErrorIs(notFoundErr, morph.ErrNotFound)
+	s.ErrorIs(invalidKeyErr, morph.ErrInvalidKey)
+	s.ErrorIs(mismatchErr, morph.ErrMismatchingTypeName)
+}
+
+func (s *RepositoryTestSuite) TestFind_IdentityMap() {
+	// arrange.
+	ctx := morph.ContextWithIdentityMap(s.ctx, morph.NewIdentityMap())
+	loaded := morph.Must(s.accounts.Find(ctx, 2))
+	_, err := s.db.Exec("DELETE FROM accounts WHERE id = 2")
+	s.Require().NoError(err)
+
+	// action.
+	account, err := s.accounts.Find(ctx, int64(2))
+
+	// assert.
+	s.Require().NoError(err)
+	s.Same(loaded, account)
+	_, err = s.accounts.Find(s.ctx, 2)
+	s.ErrorIs(err, morph.ErrNotFound)
+}
+
+func (s *RepositoryTestSuite) TestFindAll() {
+	tests := []struct {
+		name     string
+		criteria morph.Criteria
+		order    []morph.Order
+		page     morph.Page
+		expected []string
+	}{
+		{name: "All", order: []morph.Order{{Field: "ID"}}, expected: []string{"anakin", "padme", "obiwan"}},
+		{name: "Criteria", criteria: morph.Criteria{"Email": nil}, order: []morph.Order{{Field: "Username"}}, expected: []string{"obiwan", "padme"}},
+		{name: "Page", order: []morph.Order{{Field: "ID", Descending: true}}, page: morph.Page{Limit: 1, Offset: 1}, expected: []string{"padme"}},
+		{name: "NoMatches", criteria: morph.Criteria{"Username": "vader"}, expected: []string{}},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// action.
+			accounts, err := s.accounts.FindAll(s.ctx, test.criteria, test.order, test.page)
+
+			// assert.
+			s.Require().NoError(err)
+			usernames := []string{}
+			for _, account := range accounts {
+				usernames = append(usernames, account.Username)
+			}
+			s.Equal(test.expected, usernames)
+		})
+	}
+}
+
+func (s *RepositoryTestSuite) TestFindAll_IdentityMap() {
+	// arrange.
+	ctx := morph.ContextWithIdentityMap(s.ctx, morph.NewIdentityMap())
+	loaded := morph.Must(s.accounts.Find(ctx, 2))
+	loaded.Username = "queen"
+
+	// action.
+	accounts, err := s.accounts.FindAll(ctx, nil, []morph.Order{{Field: "ID"}}, morph.Page{})
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().Len(accounts, 3)
+	s.Same(loaded, accounts[1])
+	s.Equal("queen", accounts[1].Username)
+	s.Same(accounts[0], morph.Must(s.accounts.Find(ctx, 1)))
+}
+
+func (s *RepositoryTestSuite) TestFindAll_CompositeKey() {
+	// action.
+	items, err := s.items.FindAll(s.ctx, morph.Criteria{"OrderID": 1}, []morph.Order{{Field: "Line"}}, morph.Page{})
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal([]*LineItem{{OrderID: 1, Line: 1, Quantity: 5}, {OrderID: 1, Line: 2, Quantity: 1}}, items)
+}
+
+func (s *RepositoryTestSuite) TestFindAll_InvalidField() {
+	// action.
+	_, err := s.accounts.FindAll(s.ctx, morph.Criteria{"Missing": 1}, nil, morph.Page{})
+
+	// assert.
+	s.EqualError(err, `morph: no mapping for field "Missing"`)
+}
+
+func (s *RepositoryTestSuite) TestSave() {
+	// arrange.
+	created := Account{Username: "ahsoka"}
+	updated := morph.Must(s.accounts.Find(s.ctx, 1))
+	updated.Username = "vader"
+	updated.SetNickname("lord")
+	unchanged := morph.Must(s.accounts.Find(s.ctx, 3))
+
+	// action.
+	createdErr := s.accounts.Save(s.ctx, &created)
+	updatedErr := s.accounts.Save(s.ctx, updated)
+	unchangedErr := s.accounts.Save(s.ctx, unchanged)
+
+	// assert.
+	s.Require().NoError(createdErr)
+	s.Require().NoError(updatedErr)
+	s.Require().NoError(unchangedErr)
+	s.Equal(int64(4), created.ID)
+	s.Equal(&created, morph.Must(s.accounts.Find(s.ctx, 4)))
+	s.Equal(updated, morph.Must(s.accounts.Find(s.ctx, 1)))
+}
+
+func (s *RepositoryTestSuite) TestSave_CompositeKey() {
+	// arrange.
+	created := LineItem{OrderID: 2, Line: 2, Quantity: 8}
+	updated := LineItem{OrderID: 1, Line: 1, Quantity: 6}
+
+	// action.
+	createdErr := s.items.Save(s.ctx, &created)
+	updatedErr := s.items.Save(s.ctx, &updated)
+
+	// assert.
+	s.Require().NoError(createdErr)
+	s.Require().NoError(updatedErr)
+	s.Equal(&created, morph.Must(s.items.Find(s.ctx, &created)))
+	s.Equal(&updated, morph.Must(s.items.Find(s.ctx, &updated)))
+}
+
+func (s *RepositoryTestSuite) TestDelete() {
+	// arrange.
+	item := LineItem{OrderID: 1, Line: 2}
+
+	// action.
+	err := s.items.Delete(s.ctx, &item)
+
+	// assert.
+	s.Require().NoError(err)
+	s.False(morph.Must(s.items.Exists(s.ctx, &item)))
+	s.ErrorIs(s.items.Delete(s.ctx, &item), morph.ErrNoRowsAffected)
+}
+
+func (s *RepositoryTestSuite) TestSave_IdentityMap() {
+	// arrange.
+	m := morph.NewIdentityMap()
+	ctx := morph.ContextWithIdentityMap(s.ctx, m)
+	created := Account{Username: "ahsoka"}
+	deleted := morph.Must(s.accounts.Find(ctx, 3))
+
+	// action.
+	saveErr := s.accounts.Save(ctx, &created)
+	deleteErr := s.accounts.Delete(ctx, deleted)
+
+	// assert.
+	s.Require().NoError(saveErr)
+	s.Require().NoError(deleteErr)
+	s.Same(&created, morph.Must(s.accounts.Find(ctx, created.ID)))
+	_, found, err := m.Get(s.accounts.Table(), 3)
+	s.Require().NoError(err)
+	s.False(found)
+}
+
+func (s *RepositoryTestSuite) TestSave_IdentityMapRefreshed() {
+	// arrange.
+	ctx := morph.ContextWithIdentityMap(s.ctx, morph.NewIdentityMap())
+	found := morph.Must(s.accounts.Find(ctx, 1))
+	s.Require().Equal("anakin", found.Username)
+
+	// action.
+	err := s.accounts.Save(ctx, &Account{ID: 1, Username: "vader"})
+
+	// assert.
+	s.Require().NoError(err)
+	refound := morph.Must(s.accounts.Find(ctx, 1))
+	s.Same(found, refound)
+	s.Equal("vader", refound.Username)
+	s.Nil(refound.Email)
+}
+
+func (s *RepositoryTestSuite) TestExists() {
+	tests := []struct {
+		name     string
+		key      any
+		expected bool
+	}{
+		{name: "Exists", key: []any{1, 1}, expected: true},
+		{name: "Entity", key: LineItem{OrderID: 2, Line: 1}, expected: true},
+		{name: "Missing", key: []any{2, 2}, expected: false},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// action.
+			exists, err := s.items.Exists(s.ctx, test.key)
+
+			// assert.
+			s.Require().NoError(err)
+			s.Equal(test.expected, exists)
+		})
+	}
+}
+
+func (s *RepositoryTestSuite) TestExists_InvalidKey() {
+	// action.
+	_, err := s.items.Exists(s.ctx, "key")
+
+	// assert.
+	s.ErrorIs(err, morph.ErrInvalidKey)
+}
@@ -0,0 +1,269 @@
+package morph
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+//go:generate go run ./internal/schemagen -o schema.json
+
+// SchemaTitle is the title of the JSON Schema for the configuration format.
+const SchemaTitle = "morph configuration"
+
+// ErrSchemaViolation represents an error encountered when a document does not match the
+// JSON Schema for the configuration format.
+var ErrSchemaViolation = errors.New("morph: document does not match the configuration schema")
+
+// schema is the JSON Schema for the configuration format, generated from the configuration types.
+//
+//go:embed schema.json
+var schema []byte
+
+// compiled is the decoded JSON Schema for the configuration format.
+var compiled struct {
+	once sync.Once
+	root map[string]any
+}
+
+// Schema retrieves the JSON Schema for the configuration format, which editors can use to
+// validate and autocomplete configuration files.
+func Schema() []byte {
+	return append([]byte(nil), schema...)
+}
+
+// SchemaError represents a value within a document that does not match the JSON Schema
+// for the configuration format.
+type SchemaError struct {
+	// Path is the path of the value within the document, such as "tables[0].columns[1].name".
+	Path    string
+	Message string
+}
+
+// Error retrieves the message for the schema error.
+func (e *SchemaError) Error() string {
+	if e.Path == "" {
+		return "morph: " + e.Message
+	}
+	return fmt.Sprintf("morph: %s: %s", e.Path, e.Message)
+}
+
+// Is indicates if the schema error matches the provided error, which is true for ErrSchemaViolation.
+func (e *SchemaError) Is(target error) bool {
+	return target == ErrSchemaViolation
+}
+
+// ValidateSchema validates the document in the provided format, either "json" or "yaml",
+// against the JSON Schema for the configuration format. Every mismatch is reported as a
+// ValidationErrors in document order, whose errors wrap a *SchemaError and include their
+// position.
+func ValidateSchema(data []byte, format string) error {
+	return validateSchema(data, format, "")
+}
+
+// validateSchema validates the document in the provided format against the JSON Schema,
+// reporting positions within the provided file.
+func validateSchema(data []byte, format, file string) error {
+	var doc any
+	var pos positions
+	switch strings.ToLower(strings.TrimPrefix(format, ".")) {
+	case "json":
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return err
+		}
+		pos = jsonPositions(data)
+	case "yaml", "yml":
+		if err := yamlv3.Unmarshal(data, &doc); err != nil {
+			return err
+		}
+		doc, pos = normalize(doc), yamlPositions(data)
+	default:
+		return fmt.Errorf("morph: no schema validation for %q format", format)
+	}
+
+	compiled.once.Do(func() {
+		if err := json.Unmarshal(schema, &compiled.root); err != nil {
+			panic(err)
+		}
+	})
+
+	var errs ValidationErrors
+	check(compiled.root, compiled.root, "", doc, func(path, message string) {
+		errs = append(errs, &ValidationError{
+			Position: nearest(pos, path, file),
+			Err:      &SchemaError{Path: path, Message: message},
+		})
+	})
+
+	if len(errs) > 0 {
+		sort.SliceStable(errs, func(i, j int) bool {
+			pi, pj := errs[i].Position, errs[j].Position
+			return pi.Line < pj.Line || (pi.Line == pj.Line && pi.Column < pj.Column)
+		})
+		return errs
+	}
+	return nil
+}
+
+// check validates the provided value against the provided schema, reporting every mismatch.
+// Only the keywords used by the configuration schema are supported.
+func check(root, s map[string]any, path string, v any, report func(path, message string)) {
+	if ref, ok := s["$ref"].(string); ok {
+		name := strings.TrimPrefix(ref, "#/$defs/")
+		s = root["$defs"].(map[string]any)[name].(map[string]any)
+	}
+
+	if t, ok := s["type"].(string); ok && !isSchemaType(v, t) {
+		report(path, "must be of type "+t)
+		return
+	}
+
+	if enum, ok := s["enum"].([]any); ok {
+		matched := false
+		for _, e := range enum {
+			matched = matched || e == v
+		}
+		if !matched {
+			report(path, "must be one of "+quoted(enum))
+		}
+	}
+
+	if pattern, ok := s["pattern"].(string); ok {
+		if str, _ := v.(string); !regexp.MustCompile(pattern).MatchString(str) {
+			report(path, "must match the pattern "+pattern)
+		}
+	}
+
+	if anyOf, ok := s["anyOf"].([]any); ok {
+		matched := false
+		var enum []any
+		for _, sub := range anyOf {
+			sub := sub.(map[string]any)
+			valid := true
+			check(root, sub, path, v, func(string, string) { valid = false })
+			matched = matched || valid
+			if e, ok := sub["enum"].([]any); ok {
+				enum = append(enum, e...)
+			}
+		}
+		if !matched && len(enum) > 0 {
+			report(path, "must be one of "+quoted(enum))
+		} else if !matched {
+			report(path, "must match one of the allowed schemas")
+		}
+	}
+
+	switch v := v.(type) {
+	case map[string]any:
+		properties, _ := s["properties"].(map[string]any)
+		if required, ok := s["required"].([]any); ok {
+			for _, name := range required {
+				if _, ok := v[name.(string)]; !ok {
+					report(path, fmt.Sprintf("missing required property %q", name))
+				}
+			}
+		}
+
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			child := key
+			if path != "" {
+				child = path + "." + key
+			}
+			if p, ok := properties[key].(map[string]any); ok {
+				check(root, p, child, v[key], report)
+			} else if additional, ok := s["additionalProperties"].(bool); ok && !additional {
+				report(child, "is not a known property")
+			}
+		}
+	case []any:
+		if items, ok := s["items"].(map[string]any); ok {
+			for i, item := range v {
+				check(root, items, fmt.Sprintf("%s[%d]", path, i), item, report)
+			}
+		}
+	}
+}
+
+// isSchemaType determines if the provided decoded value is of the provided JSON Schema type.
+func isSchemaType(v any, t string) bool {
+	switch v := v.(type) {
+	case map[string]any:
+		return t == "object"
+	case []any:
+		return t == "array"
+	case string:
+		return t == "string"
+	case bool:
+		return t == "boolean"
+	case nil:
+		return t == "null"
+	case float64:
+		return t == "number" || (t == "integer" && v == float64(int64(v)))
+	case int, int64, uint64:
+		return t == "number" || t == "integer"
+	}
+	return false
+}
+
+// normalize converts the mappings within the provided decoded YAML value to have string keys.
+func normalize(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, val := range v {
+			v[key] = normalize(val)
+		}
+		return v
+	case map[any]any:
+		m := make(map[string]any, len(v))
+		for key, val := range v {
+			m[fmt.Sprint(key)] = normalize(val)
+		}
+		return m
+	case []any:
+		for i, val := range v {
+			v[i] = normalize(val)
+		}
+		return v
+	}
+	return v
+}
+
+// nearest retrieves the position of the value at the provided path, or of its closest
+// ancestor when the value has no position, such as a missing property.
+func nearest(pos positions, path, file string) Position {
+	for {
+		if p, ok := pos[path]; ok {
+			p.File = file
+			return p
+		}
+		if path == "" {
+			return Position{File: file}
+		}
+		i := strings.LastIndexAny(path, ".[")
+		if i < 0 {
+			i = 0
+		}
+		path = path[:i]
+	}
+}
+
+// quoted formats the provided values as a comma separated list of quoted strings.
+func quoted(values []any) string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = fmt.Sprintf("%q", v)
+	}
+	return strings.Join(s, ", ")
+}
@@ -0,0 +1,195 @@
+package morph_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/freerware/morph"
+	"github.com/freerware/morph/internal/schema"
+	"github.com/stretchr/testify/suite"
+)
+
+type SchemaTestSuite struct {
+	suite.Suite
+}
+
+func TestSchemaTestSuite(t *testing.T) {
+	suite.Run(t, new(SchemaTestSuite))
+}
+
+func (s *SchemaTestSuite) TestSchema_MatchesConfiguration() {
+	// action.
+	generated, err := schema.Generate(reflect.TypeOf(morph.Configuration{}), morph.SchemaTitle)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal(string(generated), string(morph.Schema()), "schema.json is out of date, run go generate")
+}
+
+func (s *SchemaTestSuite) TestSchema() {
+	// action.
+	var doc map[string]any
+	err := json.Unmarshal(morph.Schema(), &doc)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal(schema.Draft, doc["$schema"])
+	s.Equal(morph.SchemaTitle, doc["title"])
+}
+
+func (s *SchemaTestSuite) TestValidateSchema() {
+	tests := []struct {
+		name   string
+		format string
+		doc    string
+	}{
+		{
+			name:   "YAML",
+			format: "yaml",
+			doc: strings.Join([]string{
+				"tables:",
+				"  - typeName: example.User",
+				"    name: users",
+				"    alias: U",
+				"    include: [audit.yaml]",
+				"    columns:",
+				"      - name: id",
+				"        field: ID",
+				"        fieldType: int",
+				"        fieldStrategy: struct_field",
+				"        primaryKey: true",
+				"      - name: username",
+				"        field: Username",
+				"        fieldStrategy: ${STRATEGY:-method}",
+				"overrides:",
+				"  - typeName: example.User",
+				"    name: staging.users",
+			}, "\n"),
+		},
+		{
+			name:   "JSON",
+			format: ".json",
+			doc:    `{"tables": [{"typeName": "example.User", "name": "users", "alias": "U", "columns": [{"name": "id", "field": "ID", "fieldStrategy": "method"}]}]}`,
+		},
+		{
+			name:   "Empty",
+			format: "json",
+			doc:    `{}`,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// action + assert.
+			s.NoError(morph.ValidateSchema([]byte(test.doc), test.format))
+		})
+	}
+}
+
+func (s *SchemaTestSuite) TestValidateSchema_YAMLErrors() {
+	// arrange.
+	doc := strings.Join([]string{
+		"tables:",
+		"  - typeName: example.User",
+		"    alias: 1",
+		"    columns:",
+		"      - name: id",
+		"        field: ID",
+		"        fieldStrategy: field",
+		"        primary: true",
+		"overrides: {}",
+	}, "\n")
+
+	// action.
+	err := morph.ValidateSchema([]byte(doc), "yml")
+
+	// assert.
+	var errs morph.ValidationErrors
+	s.Require().ErrorAs(err, &errs)
+	s.Require().ErrorIs(err, morph.ErrSchemaViolation)
+	s.Equal([]string{
+		`morph: 2:5: tables[0]: missing required property "name"`,
+		`morph: 3:12: tables[0].alias: must be of type string`,
+		`morph: 7:24: tables[0].columns[0].fieldStrategy: must be one of "struct_field", "method"`,
+		`morph: 8:18: tables[0].columns[0].primary: is not a known property`,
+		`morph: 9:12: overrides: must be of type array`,
+	}, messages(errs))
+
+	var schemaErr *morph.SchemaError
+	s.Require().True(errors.As(errs[2], &schemaErr))
+	s.Equal("tables[0].columns[0].fieldStrategy", schemaErr.Path)
+}
+
+func (s *SchemaTestSuite) TestValidateSchema_JSONErrors() {
+	// arrange.
+	doc := "{\n  \"tables\": [\n    {\"typeName\": \"example.User\", \"name\": \"users\", \"alias\": \"U\", \"columns\": [{\"name\": \"id\"}]}\n  ],\n  \"version\": 2\n}"
+
+	// action.
+	err := morph.ValidateSchema([]byte(doc), "json")
+
+	// assert.
+	var errs morph.ValidationErrors
+	s.Require().ErrorAs(err, &errs)
+	s.Equal([]string{
+		`morph: 3:77: tables[0].columns[0]: missing required property "field"`,
+		`morph: 3:77: tables[0].columns[0]: missing required property "fieldStrategy"`,
+		`morph: 5:14: version: is not a known property`,
+	}, messages(errs))
+}
+
+func (s *SchemaTestSuite) TestValidateSchema_Root() {
+	// action.
+	err := morph.ValidateSchema([]byte(`[]`), "json")
+
+	// assert.
+	s.Require().ErrorIs(err, morph.ErrSchemaViolation)
+	s.EqualError(err, "morph: 1:1: must be of type object")
+}
+
+func (s *SchemaTestSuite) TestValidateSchema_Malformed() {
+	// action.
+	err := morph.ValidateSchema([]byte(`{`), "json")
+
+	// assert.
+	s.Require().Error(err)
+	s.NotErrorIs(err, morph.ErrSchemaViolation)
+}
+
+func (s *SchemaTestSuite) TestValidateSchema_UnsupportedFormat() {
+	// action.
+	err := morph.ValidateSchema([]byte(`tables = []`), "toml")
+
+	// assert.
+	s.Require().EqualError(err, `morph: no schema validation for "toml" format`)
+}
+
+func (s *SchemaTestSuite) TestLoad_ValidateSchema() {
+	// arrange.
+	doc := []byte("tables:\n  - typeName: example.User\n    nme: users\n")
+
+	// action.
+	_, lenient := morph.YAMLLoader{}.LoadBytes(doc)
+	_, strict := morph.YAMLLoader{ValidateSchema: true}.LoadBytes(doc)
+	_, valid := morph.JSONLoader{ValidateSchema: true}.Load("./test_config.json")
+	_, invalid := morph.YAMLLoader{ValidateSchema: true}.Load("./test_config.yaml")
+
+	// assert.
+	s.NoError(lenient)
+	s.Require().ErrorIs(strict, morph.ErrSchemaViolation)
+	s.ErrorContains(strict, "morph: 3:10: tables[0].nme: is not a known property")
+	s.NoError(valid)
+	s.Require().ErrorIs(invalid, morph.ErrSchemaViolation)
+	s.EqualError(invalid, `morph: ./test_config.yaml:2:5: tables[0]: missing required property "typeName"`)
+}
+
+// messages retrieves the messages of the provided validation errors.
+func messages(errs morph.ValidationErrors) []string {
+	m := make([]string, len(errs))
+	for i, err := range errs {
+		m[i] = err.Error()
+	}
+	return m
+}
@@ -2,6 +2,8 @@ package morph
 
 import (
 	"bytes"
+	"context"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"reflect"
@@ -9,6 +11,7 @@ import (
 	"sort"
 	"strings"
 	"text/template"
+	"time"
 )
 
 // Defines the various errors that can occur when interacting with tables.
@@ -38,6 +41,30 @@ var (
 
 	// ErrMissingNonPrimaryKey represents an error encountered when a table does not have any non-primary key columns.
 	ErrMissingNonPrimaryKey = errors.New("morph: table must have at least one non-primary key column")
+
+	// ErrMissingKeys represents an error encountered when a query by keys is generated
+	// without any keys.
+	ErrMissingKeys = errors.New("morph: must have at least one key")
+
+	// ErrMissingRows represents an error encountered when a query for many rows is generated
+	// without any rows.
+	ErrMissingRows = errors.New("morph: must have at least one row")
+
+	// ErrInvalidKey represents an error encountered when a key does not provide a value
+	// for each primary key column of the table.
+	ErrInvalidKey = errors.New("morph: key must provide a value for each primary key column")
+
+	// ErrNoChanges represents an error encountered when an update query is generated for
+	// changed columns but none of the non-primary key columns have changed.
+	ErrNoChanges = errors.New("morph: no columns have changed")
+
+	// ErrAllColumnsOmitted represents an error encountered when every column that a query
+	// would set has been omitted, such as when all values are empty.
+	ErrAllColumnsOmitted = errors.New("morph: all columns have been omitted from the query")
+
+	// ErrInvalidAggregateFunction represents an error encountered when an aggregate query is
+	// generated with an unsupported aggregate function.
+	ErrInvalidAggregateFunction = errors.New("morph: unsupported aggregate function")
 )
 
 var (
@@ -69,6 +96,60 @@ func (r EvaluationResult) NonEmpties() []string {
 	return nonEmpties
 }
 
+// Changes retrieves all of the keys in the result whose values differ from the
+// values in the provided snapshot, including keys that changed to or from nil.
+func (r EvaluationResult) Changes(snapshot EvaluationResult) []string {
+	var changes []string
+	for key, val := range r {
+		prev, ok := snapshot[key]
+		if !ok || !equal(prev, val) {
+			changes = append(changes, key)
+		}
+	}
+	sort.Strings(changes)
+	return changes
+}
+
+// equal determines if the provided values are equal, comparing times by instant.
+func equal(a, b any) bool {
+	if at, ok := a.(time.Time); ok {
+		bt, ok := b.(time.Time)
+		return ok && at.Equal(bt)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// deepCopy copies the provided value along with the slices and maps it contains, such that
+// the copy shares no memory with the value that can be modified in place.
+func deepCopy(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		return deepCopy(v.Elem())
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopy(v.Index(i)))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			c.SetMapIndex(iter.Key(), deepCopy(iter.Value()))
+		}
+		return c
+	}
+	return v
+}
+
 // Table represents a mapping between an entity and a database table.
 type Table struct {
 	typeName       string
@@ -76,6 +157,7 @@ type Table struct {
 	alias          string
 	columnsByName  map[string]Column
 	columnsByField map[string]Column
+	hooks          []Hook
 }
 
 // SetType associates the entity type to the table.
@@ -144,6 +226,14 @@ func (t *Table) FieldName(name string) (string, error) {
 	return "", fmt.Errorf("morph: no mapping for column %q", name)
 }
 
+// column retrieves the column associated to the provided field name.
+func (t *Table) column(field string) (Column, error) {
+	if column, ok := t.columnsByField[field]; ok {
+		return column, nil
+	}
+	return Column{}, fmt.Errorf("morph: no mapping for field %q", field)
+}
+
 // Columns retrieves all of the columns for the table.
 func (t *Table) Columns() (columns []Column) {
 	if t.columnsByName == nil {
@@ -169,6 +259,27 @@ func (t Table) FindColumns(p ColumnPredicate) []Column {
 	return cols
 }
 
+// AsConfiguration converts the table to the configuration used to construct it,
+// which is the inverse of TableConfiguration within Configuration.AsMetadata.
+func (t *Table) AsConfiguration() TableConfiguration {
+	columns := []ColumnConfiguration{}
+	for _, col := range t.Columns() {
+		columns = append(columns, ColumnConfiguration{
+			Name:          col.Name(),
+			Field:         col.Field(),
+			FieldType:     col.FieldType(),
+			FieldStrategy: col.Strategy(),
+			PrimaryKey:    col.PrimaryKey(),
+		})
+	}
+	return TableConfiguration{
+		TypeName: t.TypeName(),
+		Name:     t.Name(),
+		Alias:    t.Alias(),
+		Columns:  columns,
+	}
+}
+
 // AddColumn adds a column to the table.
 func (t *Table) AddColumn(column Column) error {
 	if t.columnsByName == nil {
@@ -202,25 +313,42 @@ func (t *Table) AddColumns(columns ...Column) error {
 
 // Evaluate applies the table to the provided object to produce a result
 // containing the column names and their respective values. The result
-// can then be subsequently used to execute queries.
+// can then be subsequently used to execute queries. The BeforeEvaluate and
+// AfterEvaluate hooks of the table are run around the evaluation.
 func (t *Table) Evaluate(obj any) (EvaluationResult, error) {
-	objType := reflect.TypeOf(obj)
-	objVal := reflect.ValueOf(obj)
+	return t.evaluateContext(context.Background(), obj)
+}
 
-	// determine the type name for the pointer version of obj.
-	ptrTypeName := fmt.Sprintf("%T", obj)
-	if objType.Kind() != reflect.Ptr {
-		ptrTypeName = fmt.Sprintf("%T", reflect.New(objVal.Type()).Interface())
+// evaluateContext evaluates the provided object, running the hooks of the table with the
+// provided context.
+func (t *Table) evaluateContext(ctx context.Context, obj any) (EvaluationResult, error) {
+	hooks := t.allHooks()
+	if len(hooks) == 0 {
+		return t.evaluate(obj)
 	}
 
-	// determine the type name for the value version of obj.
-	valTypeName := fmt.Sprintf("%T", obj)
-	if objType.Kind() == reflect.Ptr {
-		valTypeName = fmt.Sprintf("%T", objVal.Elem().Interface())
+	op := &Operation{Context: ctx, Table: t, Object: obj}
+	if err := before(hooks, op, func(h Hook) func(*Operation) error { return h.BeforeEvaluate }); err != nil {
+		return nil, err
+	}
+	result, err := t.evaluate(obj)
+	if err != nil {
+		return nil, err
+	}
+	op.Result = result
+	if err := after(hooks, op, func(h Hook) func(*Operation) error { return h.AfterEvaluate }); err != nil {
+		return nil, err
 	}
+	return op.Result, nil
+}
+
+// evaluate applies the table to the provided object without running any hooks.
+func (t *Table) evaluate(obj any) (EvaluationResult, error) {
+	objType := reflect.TypeOf(obj)
+	objVal := reflect.ValueOf(obj)
 
 	// fail if the type name for the table doesn't match both the pointer and value type names.
-	if t.typeName != ptrTypeName && t.typeName != valTypeName {
+	if !t.matchesType(obj) {
 		return nil, ErrMismatchingTypeName
 	}
 
@@ -242,7 +370,7 @@ func (t *Table) Evaluate(obj any) (EvaluationResult, error) {
 			}
 
 			if val.Kind() == reflect.Ptr && !val.IsZero() {
-				if val.Elem().Kind() == reflect.Struct {
+				if val.Elem().Kind() == reflect.Struct && val.Elem().Type() != reflect.TypeOf(time.Time{}) {
 					continue
 				}
 				val = val.Elem()
@@ -288,6 +416,30 @@ func (t *Table) Evaluate(obj any) (EvaluationResult, error) {
 	return results, nil
 }
 
+// matchesType indicates if the type name for the table matches either the
+// pointer or value type name of the provided object.
+func (t *Table) matchesType(obj any) bool {
+	objType := reflect.TypeOf(obj)
+	if objType == nil {
+		return false
+	}
+	objVal := reflect.ValueOf(obj)
+
+	// determine the type name for the pointer version of obj.
+	ptrTypeName := fmt.Sprintf("%T", obj)
+	if objType.Kind() != reflect.Ptr {
+		ptrTypeName = fmt.Sprintf("%T", reflect.New(objVal.Type()).Interface())
+	}
+
+	// determine the type name for the value version of obj.
+	valTypeName := objType.String()
+	if objType.Kind() == reflect.Ptr {
+		valTypeName = objType.Elem().String()
+	}
+
+	return t.typeName == ptrTypeName || t.typeName == valTypeName
+}
+
 // MustEvaluate performs the same operation as Evaluate but panics if an error occurs.
 func (t *Table) MustEvaluate(obj any) EvaluationResult {
 	results, err := t.Evaluate(obj)
@@ -338,12 +490,21 @@ func (t *Table) query(tmpl *template.Template, options ...QueryOption) (string,
 		opt(qo)
 	}
 
+	type aggregate struct {
+		Function AggregateFunction
+		Column   *Column
+	}
+
 	data := struct {
 		Table          *Table
 		PrimaryKeys    []Column
 		NonPrimaryKeys []Column
+		GroupBy        []Column
+		Aggregate      aggregate
+		Where          []condition
+		OrderBy        []ordering
 		Options        *QueryOptions
-		Data           EvaluationResult
+		Omitted        map[string]bool
 	}{
 		Table:          t,
 		Options:        qo,
@@ -351,11 +512,78 @@ func (t *Table) query(tmpl *template.Template, options ...QueryOption) (string,
 		NonPrimaryKeys: t.FindColumns(func(c Column) bool { return !c.PrimaryKey() }),
 	}
 
+	for _, field := range qo.GroupBy {
+		column, err := t.column(field)
+		if err != nil {
+			return "", err
+		}
+		data.GroupBy = append(data.GroupBy, column)
+	}
+
+	if qo.criteria != nil {
+		where, err := t.conditions(qo.criteria)
+		if err != nil {
+			return "", err
+		}
+		data.Where = where
+	}
+
+	for _, o := range qo.order {
+		column, err := t.column(o.Field)
+		if err != nil {
+			return "", err
+		}
+		data.OrderBy = append(data.OrderBy, ordering{Name: column.Name(), Descending: o.Descending})
+	}
+
+	if qo.aggregate != "" {
+		if !qo.aggregate.valid() {
+			return "", ErrInvalidAggregateFunction
+		}
+		data.Aggregate.Function = qo.aggregate
+		if qo.field != "" || qo.aggregate != AggregateCount {
+			column, err := t.column(qo.field)
+			if err != nil {
+				return "", err
+			}
+			data.Aggregate.Column = &column
+		}
+	}
+
+	data.Omitted = make(map[string]bool)
 	if qo.OmitEmpty && qo.obj != nil {
-		var err error
-		if data.Data, err = t.Evaluate(qo.obj); err != nil {
+		result, err := t.evaluateContext(qo.context(), qo.obj)
+		if err != nil {
 			return "", err
 		}
+		empty := qo.Empty
+		if empty == nil {
+			empty = EmptyIfNil
+		}
+		for name, val := range result {
+			if empty(t.columnsByName[name], val) {
+				data.Omitted[name] = true
+			}
+		}
+	}
+
+	if qo.columns != nil {
+		var columns []Column
+		for _, c := range data.NonPrimaryKeys {
+			for _, name := range qo.columns {
+				if c.Name() == name {
+					columns = append(columns, c)
+					break
+				}
+			}
+		}
+		data.NonPrimaryKeys = columns
+	}
+
+	hooks := t.allHooks()
+	op := &Operation{Context: qo.context(), Table: t, Statement: tmpl.Name()}
+	if err := before(hooks, op, func(h Hook) func(*Operation) error { return h.BeforeQuery }); err != nil {
+		return "", err
 	}
 
 	buf := new(bytes.Buffer)
@@ -364,17 +592,51 @@ func (t *Table) query(tmpl *template.Template, options ...QueryOption) (string,
 		return "", err
 	}
 
-	return buf.String(), nil
+	if qo.substituted {
+		return buf.String(), nil
+	}
+	return t.afterQuery(tmpl, buf.String(), qo)
+}
+
+// afterQuery runs the AfterQuery hooks of the table for the provided query generated using
+// the provided template.
+func (t *Table) afterQuery(tmpl *template.Template, query string, qo *QueryOptions) (string, error) {
+	hooks := t.allHooks()
+	if len(hooks) == 0 {
+		return query, nil
+	}
+
+	op := &Operation{Context: qo.context(), Table: t, Statement: tmpl.Name(), Query: query}
+	if err := after(hooks, op, func(h Hook) func(*Operation) error { return h.AfterQuery }); err != nil {
+		return "", err
+	}
+	return op.Query, nil
+}
+
+// withArgs runs the AfterArgs hooks of the table for the provided query generated using
+// the provided template, along with its arguments.
+func (t *Table) withArgs(tmpl *template.Template, query string, args []any, options ...QueryOption) (string, []any, error) {
+	hooks := t.allHooks()
+	if len(hooks) == 0 {
+		return query, args, nil
+	}
+
+	qo := newQueryOptions(options...)
+	op := &Operation{Context: qo.context(), Table: t, Statement: tmpl.Name(), Query: query, Args: args}
+	if err := after(hooks, op, func(h Hook) func(*Operation) error { return h.AfterArgs }); err != nil {
+		return "", nil, err
+	}
+	return op.Query, op.Args, nil
 }
 
-func (t *Table) queryWithArgs(namedQuery string, obj any, options ...QueryOption) (string, []any, error) {
+func (t *Table) queryWithArgs(tmpl *template.Template, namedQuery string, obj any, options ...QueryOption) (string, []any, error) {
 	qo := &QueryOptions{}
 	opts := append(DefaultQueryOptions, options...)
 	for _, opt := range opts {
 		opt(qo)
 	}
 
-	result, err := t.Evaluate(obj)
+	result, err := t.evaluateContext(qo.context(), obj)
 	if err != nil {
 		return "", nil, err
 	}
@@ -403,7 +665,10 @@ func (t *Table) queryWithArgs(namedQuery string, obj any, options ...QueryOption
 		return "", nil, errors.New("morph: missing values for named parameters: " + strings.Join(missing, ", "))
 	}
 
-	return query, args, nil
+	if query, err = t.afterQuery(tmpl, query, qo); err != nil {
+		return "", nil, err
+	}
+	return t.withArgs(tmpl, query, args, options...)
 }
 
 // InsertQuery generates an INSERT query for the table.
@@ -414,13 +679,13 @@ func (t *Table) InsertQuery(options ...QueryOption) (string, error) {
 // InsertQueryWithArgs generates an INSERT query for the table along with arguments
 // derived from the provided object.
 func (t *Table) InsertQueryWithArgs(obj any, options ...QueryOption) (string, []any, error) {
-	opts := append(options, WithNamedParameters())
+	opts := append(options, withSubstitutedParameters())
 	query, err := t.InsertQuery(opts...)
 	if err != nil {
 		return "", nil, err
 	}
 
-	return t.queryWithArgs(query, obj, opts...)
+	return t.queryWithArgs(insertTmpl, query, obj, opts...)
 }
 
 // MustInsertQuery performs the same operation as InsertQuery but panics if an error occurs.
@@ -428,6 +693,44 @@ func (t *Table) MustInsertQuery(options ...QueryOption) string {
 	return Must(t.InsertQuery(options...))
 }
 
+// InsertRowsQuery generates an INSERT query for the table that inserts the provided number of rows.
+func (t *Table) InsertRowsQuery(count int, options ...QueryOption) (string, error) {
+	if count < 1 {
+		return "", ErrMissingRows
+	}
+	opts := append(options, withRows(count))
+	return t.query(insertRowsTmpl, opts...)
+}
+
+// InsertRowsQueryWithArgs generates an INSERT query for the table that inserts a row for each
+// of the provided objects, along with arguments derived from them.
+func (t *Table) InsertRowsQueryWithArgs(objs []any, options ...QueryOption) (string, []any, error) {
+	opts := append(options, withoutNamedParameters())
+	query, err := t.InsertRowsQuery(len(objs), opts...)
+	if err != nil {
+		return "", nil, err
+	}
+
+	ctx := newQueryOptions(opts...).context()
+	args := make([]any, 0, len(objs)*len(t.columnsByName))
+	columns := t.Columns()
+	for _, obj := range objs {
+		result, err := t.evaluateContext(ctx, obj)
+		if err != nil {
+			return "", nil, err
+		}
+		for _, col := range columns {
+			args = append(args, result[col.Name()])
+		}
+	}
+	return t.withArgs(insertRowsTmpl, query, args, opts...)
+}
+
+// MustInsertRowsQuery performs the same operation as InsertRowsQuery but panics if an error occurs.
+func (t *Table) MustInsertRowsQuery(count int, options ...QueryOption) string {
+	return Must(t.InsertRowsQuery(count, options...))
+}
+
 // UpdateQuery generates an UPDATE query for the table.
 func (t *Table) UpdateQuery(options ...QueryOption) (string, error) {
 	return t.query(updateTmpl, options...)
@@ -436,13 +739,52 @@ func (t *Table) UpdateQuery(options ...QueryOption) (string, error) {
 // UpdateQueryWithArgs generates an UPDATE query for the table along with arguments
 // derived from the provided object.
 func (t *Table) UpdateQueryWithArgs(obj any, options ...QueryOption) (string, []any, error) {
-	opts := append(options, WithNamedParameters())
+	opts := append(options, withSubstitutedParameters())
 	query, err := t.UpdateQuery(opts...)
 	if err != nil {
 		return "", nil, err
 	}
 
-	return t.queryWithArgs(query, obj, opts...)
+	return t.queryWithArgs(updateTmpl, query, obj, opts...)
+}
+
+// Snapshot captures the column values of the provided object, which can later be
+// provided to UpdateChangedQueryWithArgs to update only the columns that changed.
+// Slices and maps are copied, so modifying them in place is detected as a change.
+func (t *Table) Snapshot(obj any) (EvaluationResult, error) {
+	result, err := t.Evaluate(obj)
+	if err != nil {
+		return nil, err
+	}
+	for name, val := range result {
+		if val != nil {
+			result[name] = deepCopy(reflect.ValueOf(val)).Interface()
+		}
+	}
+	return result, nil
+}
+
+// UpdateChangedQueryWithArgs generates an UPDATE query for the table that only sets
+// the columns whose values differ between the provided snapshot and object, along
+// with arguments derived from the provided object.
+func (t *Table) UpdateChangedQueryWithArgs(snapshot EvaluationResult, obj any, options ...QueryOption) (string, []any, error) {
+	result, err := t.evaluateContext(newQueryOptions(options...).context(), obj)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var changes []string
+	for _, name := range result.Changes(snapshot) {
+		if col, ok := t.columnsByName[name]; ok && !col.PrimaryKey() {
+			changes = append(changes, name)
+		}
+	}
+	if len(changes) == 0 {
+		return "", nil, ErrNoChanges
+	}
+
+	opts := append(options, withColumns(changes...))
+	return t.UpdateQueryWithArgs(obj, opts...)
 }
 
 // MustUpdateQuery performs the same operation as UpdateQuery but panics if an error occurs.
@@ -458,13 +800,13 @@ func (t *Table) DeleteQuery(options ...QueryOption) (string, error) {
 // DeleteQueryWithArgs generates a DELETE query for the table along with arguments
 // derived from the provided object.
 func (t *Table) DeleteQueryWithArgs(obj any, options ...QueryOption) (string, []any, error) {
-	opts := append(options, WithNamedParameters())
+	opts := append(options, withSubstitutedParameters())
 	query, err := t.DeleteQuery(opts...)
 	if err != nil {
 		return "", nil, err
 	}
 
-	return t.queryWithArgs(query, obj, opts...)
+	return t.queryWithArgs(deleteTmpl, query, obj, opts...)
 }
 
 // MustDeleteQuery performs the same operation as DeleteQuery but panics if an error occurs.
@@ -480,16 +822,269 @@ func (t *Table) SelectQuery(options ...QueryOption) (string, error) {
 // SelectQueryWithArgs generates a SELECT query for the table along with arguments
 // derived from the provided object.
 func (t *Table) SelectQueryWithArgs(obj any, options ...QueryOption) (string, []any, error) {
-	opts := append(options, WithNamedParameters())
+	opts := append(options, withSubstitutedParameters())
 	query, err := t.SelectQuery(opts...)
 	if err != nil {
 		return "", nil, err
 	}
 
-	return t.queryWithArgs(query, obj, opts...)
+	return t.queryWithArgs(selectTmpl, query, obj, opts...)
 }
 
 // MustSelectQuery performs the same operation as SelectQuery but panics if an error occurs.
 func (t *Table) MustSelectQuery(options ...QueryOption) string {
 	return Must(t.SelectQuery(options...))
 }
+
+// Statements represents the INSERT, UPDATE, DELETE and SELECT queries for a table.
+type Statements struct {
+	Insert string
+	Update string
+	Delete string
+	Select string
+}
+
+// Statements generates the INSERT, UPDATE, DELETE and SELECT queries for the table.
+func (t *Table) Statements(options ...QueryOption) (Statements, error) {
+	var s Statements
+	var err error
+	if s.Insert, err = t.InsertQuery(options...); err != nil {
+		return Statements{}, err
+	}
+	if s.Update, err = t.UpdateQuery(options...); err != nil {
+		return Statements{}, err
+	}
+	if s.Delete, err = t.DeleteQuery(options...); err != nil {
+		return Statements{}, err
+	}
+	if s.Select, err = t.SelectQuery(options...); err != nil {
+		return Statements{}, err
+	}
+	return s, nil
+}
+
+// CountQuery generates a SELECT COUNT(*) query for the table.
+func (t *Table) CountQuery(options ...QueryOption) (string, error) {
+	return t.AggregateQuery(AggregateCount, "", options...)
+}
+
+// MustCountQuery performs the same operation as CountQuery but panics if an error occurs.
+func (t *Table) MustCountQuery(options ...QueryOption) string {
+	return Must(t.CountQuery(options...))
+}
+
+// ExistsQuery generates a SELECT EXISTS query for the table that checks for
+// the presence of a row by primary key.
+func (t *Table) ExistsQuery(options ...QueryOption) (string, error) {
+	return t.query(existsTmpl, options...)
+}
+
+// ExistsQueryWithArgs generates a SELECT EXISTS query for the table along with arguments
+// derived from the provided object.
+func (t *Table) ExistsQueryWithArgs(obj any, options ...QueryOption) (string, []any, error) {
+	opts := append(options, withSubstitutedParameters())
+	query, err := t.ExistsQuery(opts...)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return t.queryWithArgs(existsTmpl, query, obj, opts...)
+}
+
+// MustExistsQuery performs the same operation as ExistsQuery but panics if an error occurs.
+func (t *Table) MustExistsQuery(options ...QueryOption) string {
+	return Must(t.ExistsQuery(options...))
+}
+
+// AggregateQuery generates a SELECT query for the table that applies the provided
+// aggregate function to the column mapped to the provided field. The field may be
+// empty for AggregateCount, in which case all rows are counted.
+func (t *Table) AggregateQuery(fn AggregateFunction, field string, options ...QueryOption) (string, error) {
+	opts := append(options, withAggregate(fn, field))
+	return t.query(aggregateTmpl, opts...)
+}
+
+// MustAggregateQuery performs the same operation as AggregateQuery but panics if an error occurs.
+func (t *Table) MustAggregateQuery(fn AggregateFunction, field string, options ...QueryOption) string {
+	return Must(t.AggregateQuery(fn, field, options...))
+}
+
+// SumQuery generates a SELECT SUM query for the column mapped to the provided field.
+func (t *Table) SumQuery(field string, options ...QueryOption) (string, error) {
+	return t.AggregateQuery(AggregateSum, field, options...)
+}
+
+// MinQuery generates a SELECT MIN query for the column mapped to the provided field.
+func (t *Table) MinQuery(field string, options ...QueryOption) (string, error) {
+	return t.AggregateQuery(AggregateMin, field, options...)
+}
+
+// MaxQuery generates a SELECT MAX query for the column mapped to the provided field.
+func (t *Table) MaxQuery(field string, options ...QueryOption) (string, error) {
+	return t.AggregateQuery(AggregateMax, field, options...)
+}
+
+// AvgQuery generates a SELECT AVG query for the column mapped to the provided field.
+func (t *Table) AvgQuery(field string, options ...QueryOption) (string, error) {
+	return t.AggregateQuery(AggregateAvg, field, options...)
+}
+
+// keyValues retrieves the primary key values for the provided key, which is either
+// an object of the table type, a single value for tables with one primary key column,
+// or a slice of values ordered by primary key column name for composite keys. Objects
+// of any other type are rejected rather than treated as a single value.
+func (t *Table) keyValues(ctx context.Context, primaryKeys []Column, key any) ([]any, error) {
+	if t.matchesType(key) {
+		result, err := t.evaluateContext(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]any, 0, len(primaryKeys))
+		for _, col := range primaryKeys {
+			values = append(values, result[col.Name()])
+		}
+		return values, nil
+	}
+
+	if isObject(key) {
+		return nil, ErrMismatchingTypeName
+	}
+
+	if len(primaryKeys) == 1 {
+		return []any{key}, nil
+	}
+
+	values, ok := key.([]any)
+	if !ok || len(values) != len(primaryKeys) {
+		return nil, ErrInvalidKey
+	}
+	return values, nil
+}
+
+// isObject indicates if the provided key is a struct or pointer to a struct that is
+// not itself a value, such as time.Time or a driver.Valuer.
+func isObject(key any) bool {
+	if _, ok := key.(driver.Valuer); ok {
+		return false
+	}
+	typ := reflect.TypeOf(key)
+	if typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ != nil && typ.Kind() == reflect.Struct && typ != reflect.TypeOf(time.Time{})
+}
+
+// byKeysQuery generates a query for the table using the provided template for the provided number of keys.
+func (t *Table) byKeysQuery(tmpl *template.Template, count int, options ...QueryOption) (string, error) {
+	if count < 1 {
+		return "", ErrMissingKeys
+	}
+	opts := append(options, withKeys(count))
+	return t.query(tmpl, opts...)
+}
+
+// byKeysQueryWithArgs generates a query for the table using the provided template along with
+// arguments derived from the provided keys.
+func (t *Table) byKeysQueryWithArgs(tmpl *template.Template, keys []any, options ...QueryOption) (string, []any, error) {
+	opts := append(options, withoutNamedParameters())
+	query, err := t.byKeysQuery(tmpl, len(keys), opts...)
+	if err != nil {
+		return "", nil, err
+	}
+
+	ctx := newQueryOptions(opts...).context()
+	args := []any{}
+	primaryKeys := t.FindColumns(func(c Column) bool { return c.PrimaryKey() })
+	for _, key := range keys {
+		values, err := t.keyValues(ctx, primaryKeys, key)
+		if err != nil {
+			return "", nil, err
+		}
+		args = append(args, values...)
+	}
+
+	return t.withArgs(tmpl, query, args, opts...)
+}
+
+// SelectByKeysQuery generates a SELECT query for the table that retrieves the rows
+// matching the provided number of primary keys.
+func (t *Table) SelectByKeysQuery(count int, options ...QueryOption) (string, error) {
+	return t.byKeysQuery(selectByKeysTmpl, count, options...)
+}
+
+// SelectByKeysQueryWithArgs generates a SELECT query for the table along with arguments
+// derived from the provided keys. Each key is either an object of the table type, a single
+// value for tables with one primary key column, or a []any containing a value for each
+// primary key column ordered by column name.
+func (t *Table) SelectByKeysQueryWithArgs(keys []any, options ...QueryOption) (string, []any, error) {
+	return t.byKeysQueryWithArgs(selectByKeysTmpl, keys, options...)
+}
+
+// MustSelectByKeysQuery performs the same operation as SelectByKeysQuery but panics if an error occurs.
+func (t *Table) MustSelectByKeysQuery(count int, options ...QueryOption) string {
+	return Must(t.SelectByKeysQuery(count, options...))
+}
+
+// DeleteByKeysQuery generates a DELETE query for the table that removes the rows
+// matching the provided number of primary keys.
+func (t *Table) DeleteByKeysQuery(count int, options ...QueryOption) (string, error) {
+	return t.byKeysQuery(deleteByKeysTmpl, count, options...)
+}
+
+// DeleteByKeysQueryWithArgs generates a DELETE query for the table along with arguments
+// derived from the provided keys. Keys are interpreted in the same way as SelectByKeysQueryWithArgs.
+func (t *Table) DeleteByKeysQueryWithArgs(keys []any, options ...QueryOption) (string, []any, error) {
+	return t.byKeysQueryWithArgs(deleteByKeysTmpl, keys, options...)
+}
+
+// MustDeleteByKeysQuery performs the same operation as DeleteByKeysQuery but panics if an error occurs.
+func (t *Table) MustDeleteByKeysQuery(count int, options ...QueryOption) string {
+	return Must(t.DeleteByKeysQuery(count, options...))
+}
+
+// conditions retrieves the comparisons of the columns mapped to the fields of the provided
+// criteria, ordered by column name.
+func (t *Table) conditions(criteria Criteria) ([]condition, error) {
+	conditions := make([]condition, 0, len(criteria))
+	for field, value := range criteria {
+		column, err := t.column(field)
+		if err != nil {
+			return nil, err
+		}
+		null := value == nil
+		if v := reflect.ValueOf(value); v.Kind() == reflect.Ptr && v.IsNil() {
+			null = true
+		}
+		conditions = append(conditions, condition{Name: column.Name(), Value: value, Null: null})
+	}
+	sort.Slice(conditions, func(i, j int) bool {
+		return conditions[i].Name < conditions[j].Name
+	})
+	return conditions, nil
+}
+
+// SelectWhereQueryWithArgs generates a SELECT query for the table that retrieves the rows
+// matching the provided criteria, ordered by the provided orders and limited to the provided
+// page, along with arguments derived from the criteria.
+func (t *Table) SelectWhereQueryWithArgs(criteria Criteria, order []Order, page Page, options ...QueryOption) (string, []any, error) {
+	if criteria == nil {
+		criteria = Criteria{}
+	}
+	opts := append(options, withoutNamedParameters(), withWhere(criteria, order, page))
+	query, err := t.query(selectWhereTmpl, opts...)
+	if err != nil {
+		return "", nil, err
+	}
+
+	conditions, err := t.conditions(criteria)
+	if err != nil {
+		return "", nil, err
+	}
+	args := []any{}
+	for _, c := range conditions {
+		if !c.Null {
+			args = append(args, c.Value)
+		}
+	}
+	return t.withArgs(selectWhereTmpl, query, args, opts...)
+}
@@ -535,6 +535,18 @@ func (s *TableTestSuite) TestTable_EvaluateWithPointer() {
 				)
 			},
 		},
+		{
+			name:           "TimePointersDereferenced",
+			reflectOptions: []morph.ReflectOption{},
+			preparations: func() TestModel {
+				deletedAt := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+				return TestModel{ID: 1, DeletedAt: &deletedAt}
+			},
+			assertions: func(result morph.EvaluationResult, err error) {
+				s.NoError(err)
+				s.Equal(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), result["deleted_at"])
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -1260,6 +1272,92 @@ func (s *TableTestSuite) TestTable_InsertQueryWithArgs_InvalidTable() {
 	s.Empty(args)
 }
 
+func (s *TableTestSuite) TestTable_InsertRowsQuery() {
+	tests := []struct {
+		name         string
+		count        int
+		queryOptions []morph.QueryOption
+		assertions   func(query string, err error)
+	}{
+		{
+			name:  "NoOptions",
+			count: 2,
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("INSERT INTO test_models (created_at, deleted_at, id, maybe_ignore, name, updated_at) VALUES (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?);", query)
+			},
+		},
+		{
+			name:         "WithPlaceholder_WithOrdering",
+			count:        2,
+			queryOptions: []morph.QueryOption{morph.WithPlaceholder("$", true)},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("INSERT INTO test_models (created_at, deleted_at, id, maybe_ignore, name, updated_at) VALUES ($1, $2, $3, $4, $5, $6), ($7, $8, $9, $10, $11, $12);", query)
+			},
+		},
+		{
+			name:         "WithNamedParameters",
+			count:        2,
+			queryOptions: []morph.QueryOption{morph.WithNamedParameters()},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("INSERT INTO test_models (created_at, deleted_at, id, maybe_ignore, name, updated_at) VALUES (:created_at_0, :deleted_at_0, :id_0, :maybe_ignore_0, :name_0, :updated_at_0), (:created_at_1, :deleted_at_1, :id_1, :maybe_ignore_1, :name_1, :updated_at_1);", query)
+			},
+		},
+		{
+			name:  "MissingRows",
+			count: 0,
+			assertions: func(query string, err error) {
+				s.ErrorIs(err, morph.ErrMissingRows)
+				s.Empty(query)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// arrange.
+			var err error
+			s.sut, err = morph.Reflect(&TestModel{})
+			if err != nil {
+				s.FailNow("unable to reflect in test", err)
+			}
+
+			// action.
+			query, err := s.sut.InsertRowsQuery(test.count, test.queryOptions...)
+
+			// assert.
+			test.assertions(query, err)
+		})
+	}
+}
+
+func (s *TableTestSuite) TestTable_InsertRowsQueryWithArgs() {
+	// arrange.
+	var err error
+	s.sut, err = morph.Reflect(&TestModel{})
+	if err != nil {
+		s.FailNow("unable to reflect in test", err)
+	}
+	first, second := "first", "second"
+	objs := []any{&TestModel{ID: 1, Name: &first}, &TestModel{ID: 2, Name: &second}}
+
+	// action.
+	query, args, err := s.sut.InsertRowsQueryWithArgs(objs, morph.WithNamedParameters(), morph.WithPlaceholder("$", true))
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal("INSERT INTO test_models (created_at, deleted_at, id, maybe_ignore, name, updated_at) VALUES ($1, $2, $3, $4, $5, $6), ($7, $8, $9, $10, $11, $12);", query)
+	s.Require().Len(args, 12)
+	s.Equal([]any{1, first, 2, second}, []any{args[2], args[4], args[8], args[10]})
+}
+
+func (s *TableTestSuite) TestTable_MustInsertRowsQuery_InvalidTable() {
+	// action + assert.
+	s.Panics(func() { s.sut.MustInsertRowsQuery(1) })
+}
+
 func (s *TableTestSuite) TestTable_UpdateQuery_InvalidTable() {
 	// action.
 	query, err := s.sut.UpdateQuery()
@@ -1296,6 +1394,18 @@ func (s *TableTestSuite) TestTable_UpdateQuery() {
 				s.Equal("UPDATE test_models AS T SET T.created_at = ?, T.deleted_at = ?, T.maybe_ignore = ?, T.name = ?, T.updated_at = ? WHERE 1=1 AND T.id = ?;", query)
 			},
 		},
+		{
+			name:         "UnqualifiedSet",
+			queryOptions: func(m TestModel) []morph.QueryOption { return morph.Dialects["postgres"] },
+			preparations: func() TestModel {
+				name := "test"
+				return TestModel{ID: 1, Name: &name}
+			},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("UPDATE test_models AS T SET created_at = $1, deleted_at = $2, maybe_ignore = $3, name = $4, updated_at = $5 WHERE 1=1 AND T.id = $6;", query)
+			},
+		},
 		{
 			name:         "WithoutEmptyValues",
 			queryOptions: func(m TestModel) []morph.QueryOption { return []morph.QueryOption{morph.WithoutEmptyValues(&m)} },
@@ -2356,3 +2466,1195 @@ func (s *TableTestSuite) TestTable_EvaluationResults_NonEmpties() {
 	s.Len(result.NonEmpties(), 2)
 	s.ElementsMatch(result.NonEmpties(), []string{"id", "title"})
 }
+
+func (s *TableTestSuite) TestTable_Statements_InvalidTable() {
+	// action.
+	statements, err := s.sut.Statements()
+
+	// assert.
+	s.Error(err)
+	s.Equal(morph.Statements{}, statements)
+}
+
+func (s *TableTestSuite) TestTable_Statements() {
+	// arrange.
+	var err error
+	s.sut, err = morph.Reflect(&TestModel{})
+	if err != nil {
+		s.FailNow("unable to reflect in test", err)
+	}
+	options := morph.Dialects["postgres"]
+
+	// action.
+	statements, err := s.sut.Statements(options...)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal(morph.Statements{
+		Insert: s.sut.MustInsertQuery(options...),
+		Update: s.sut.MustUpdateQuery(options...),
+		Delete: s.sut.MustDeleteQuery(options...),
+		Select: s.sut.MustSelectQuery(options...),
+	}, statements)
+	s.Equal("DELETE FROM test_models WHERE 1=1 AND id = $1;", statements.Delete)
+}
+
+func (s *TableTestSuite) TestTable_CountQuery_InvalidTable() {
+	// action.
+	query, err := s.sut.CountQuery()
+
+	// assert.
+	s.Error(err)
+	s.Empty(query)
+}
+
+func (s *TableTestSuite) TestTable_CountQuery() {
+	tests := []struct {
+		name         string
+		queryOptions []morph.QueryOption
+		assertions   func(query string, err error)
+	}{
+		{
+			name:         "NoOptions",
+			queryOptions: []morph.QueryOption{},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT COUNT(*) FROM test_models AS T;", query)
+			},
+		},
+		{
+			name:         "WithGroupBy",
+			queryOptions: []morph.QueryOption{morph.WithGroupBy("Name", "MaybeIgnore")},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.name, T.maybe_ignore, COUNT(*) FROM test_models AS T GROUP BY T.name, T.maybe_ignore;", query)
+			},
+		},
+		{
+			name:         "WithGroupBy_MissingMapping",
+			queryOptions: []morph.QueryOption{morph.WithGroupBy("Missing")},
+			assertions: func(query string, err error) {
+				s.Require().Error(err)
+				s.Empty(query)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// arrange.
+			var err error
+			s.sut, err = morph.Reflect(&TestModel{})
+			if err != nil {
+				s.FailNow("unable to reflect in test", err)
+			}
+
+			// action.
+			query, err := s.sut.CountQuery(test.queryOptions...)
+
+			// assert.
+			test.assertions(query, err)
+		})
+	}
+}
+
+func (s *TableTestSuite) TestTable_MustCountQuery_InvalidTable() {
+	// action + assert.
+	s.Panics(func() { s.sut.MustCountQuery() })
+}
+
+func (s *TableTestSuite) TestTable_ExistsQuery_InvalidTable() {
+	// action.
+	query, err := s.sut.ExistsQuery()
+
+	// assert.
+	s.Error(err)
+	s.Empty(query)
+}
+
+func (s *TableTestSuite) TestTable_ExistsQuery() {
+	tests := []struct {
+		name         string
+		queryOptions []morph.QueryOption
+		assertions   func(query string, err error)
+	}{
+		{
+			name:         "NoOptions",
+			queryOptions: []morph.QueryOption{},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT EXISTS (SELECT 1 FROM test_models AS T WHERE 1=1 AND T.id = ?);", query)
+			},
+		},
+		{
+			name:         "WithPlaceholder_WithOrdering",
+			queryOptions: []morph.QueryOption{morph.WithPlaceholder("$", true)},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT EXISTS (SELECT 1 FROM test_models AS T WHERE 1=1 AND T.id = $1);", query)
+			},
+		},
+		{
+			name:         "WithNamedParameters",
+			queryOptions: []morph.QueryOption{morph.WithNamedParameters()},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT EXISTS (SELECT 1 FROM test_models AS T WHERE 1=1 AND T.id = :id);", query)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// arrange.
+			var err error
+			s.sut, err = morph.Reflect(&TestModel{})
+			if err != nil {
+				s.FailNow("unable to reflect in test", err)
+			}
+
+			// action.
+			query, err := s.sut.ExistsQuery(test.queryOptions...)
+
+			// assert.
+			test.assertions(query, err)
+		})
+	}
+}
+
+func (s *TableTestSuite) TestTable_ExistsQueryWithArgs() {
+	// arrange.
+	model := TestModel{ID: 1}
+
+	var err error
+	s.sut, err = morph.Reflect(&model)
+	if err != nil {
+		s.FailNow("unable to reflect in test", err)
+	}
+
+	// action.
+	query, args, err := s.sut.ExistsQueryWithArgs(&model, morph.WithPlaceholder("$", true))
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal("SELECT EXISTS (SELECT 1 FROM test_models AS T WHERE 1=1 AND T.id = $1);", query)
+	s.Equal([]any{model.ID}, args)
+}
+
+func (s *TableTestSuite) TestTable_MustExistsQuery_InvalidTable() {
+	// action + assert.
+	s.Panics(func() { s.sut.MustExistsQuery() })
+}
+
+func (s *TableTestSuite) TestTable_AggregateQuery() {
+	tests := []struct {
+		name         string
+		function     morph.AggregateFunction
+		field        string
+		queryOptions []morph.QueryOption
+		assertions   func(query string, err error)
+	}{
+		{
+			name:     "Sum",
+			function: morph.AggregateSum,
+			field:    "ID",
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT SUM(T.id) FROM test_models AS T;", query)
+			},
+		},
+		{
+			name:     "Min",
+			function: morph.AggregateMin,
+			field:    "UpdatedAt",
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT MIN(T.updated_at) FROM test_models AS T;", query)
+			},
+		},
+		{
+			name:     "Max",
+			function: morph.AggregateMax,
+			field:    "CreatedAt",
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT MAX(T.created_at) FROM test_models AS T;", query)
+			},
+		},
+		{
+			name:         "Avg_WithGroupBy",
+			function:     morph.AggregateAvg,
+			field:        "ID",
+			queryOptions: []morph.QueryOption{morph.WithGroupBy("Name")},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.name, AVG(T.id) FROM test_models AS T GROUP BY T.name;", query)
+			},
+		},
+		{
+			name:     "Count_WithField",
+			function: morph.AggregateCount,
+			field:    "Name",
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT COUNT(T.name) FROM test_models AS T;", query)
+			},
+		},
+		{
+			name:     "MissingField",
+			function: morph.AggregateSum,
+			field:    "",
+			assertions: func(query string, err error) {
+				s.Require().Error(err)
+				s.Empty(query)
+			},
+		},
+		{
+			name:     "MissingMapping",
+			function: morph.AggregateSum,
+			field:    "Missing",
+			assertions: func(query string, err error) {
+				s.Require().Error(err)
+				s.Empty(query)
+			},
+		},
+		{
+			name:     "UnsupportedFunction",
+			function: morph.AggregateFunction("MEDIAN"),
+			field:    "ID",
+			assertions: func(query string, err error) {
+				s.Require().ErrorIs(err, morph.ErrInvalidAggregateFunction)
+				s.Empty(query)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// arrange.
+			var err error
+			s.sut, err = morph.Reflect(&TestModel{})
+			if err != nil {
+				s.FailNow("unable to reflect in test", err)
+			}
+
+			// action.
+			query, err := s.sut.AggregateQuery(test.function, test.field, test.queryOptions...)
+
+			// assert.
+			test.assertions(query, err)
+		})
+	}
+}
+
+func (s *TableTestSuite) TestTable_AggregateQuery_Shorthands() {
+	// arrange.
+	var err error
+	s.sut, err = morph.Reflect(&TestModel{})
+	if err != nil {
+		s.FailNow("unable to reflect in test", err)
+	}
+
+	// action + assert.
+	s.Equal("SELECT SUM(T.id) FROM test_models AS T;", morph.Must(s.sut.SumQuery("ID")))
+	s.Equal("SELECT MIN(T.id) FROM test_models AS T;", morph.Must(s.sut.MinQuery("ID")))
+	s.Equal("SELECT MAX(T.id) FROM test_models AS T;", morph.Must(s.sut.MaxQuery("ID")))
+	s.Equal("SELECT AVG(T.id) FROM test_models AS T;", morph.Must(s.sut.AvgQuery("ID")))
+}
+
+func (s *TableTestSuite) TestTable_MustAggregateQuery_InvalidTable() {
+	// action + assert.
+	s.Panics(func() { s.sut.MustAggregateQuery(morph.AggregateSum, "ID") })
+}
+
+func (s *TableTestSuite) TestTable_SelectByKeysQuery() {
+	tests := []struct {
+		name           string
+		count          int
+		reflectOptions []morph.ReflectOption
+		queryOptions   []morph.QueryOption
+		assertions     func(query string, err error)
+	}{
+		{
+			name:  "SingleKey",
+			count: 3,
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.created_at, T.deleted_at, T.id, T.maybe_ignore, T.name, T.updated_at FROM test_models AS T WHERE T.id IN (?, ?, ?);", query)
+			},
+		},
+		{
+			name:         "SingleKey_WithPlaceholder_WithOrdering",
+			count:        2,
+			queryOptions: []morph.QueryOption{morph.WithPlaceholder("$", true)},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.created_at, T.deleted_at, T.id, T.maybe_ignore, T.name, T.updated_at FROM test_models AS T WHERE T.id IN ($1, $2);", query)
+			},
+		},
+		{
+			name:         "SingleKey_WithNamedParameters",
+			count:        2,
+			queryOptions: []morph.QueryOption{morph.WithNamedParameters()},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.created_at, T.deleted_at, T.id, T.maybe_ignore, T.name, T.updated_at FROM test_models AS T WHERE T.id IN (:id_0, :id_1);", query)
+			},
+		},
+		{
+			name:           "CompositeKey",
+			count:          2,
+			reflectOptions: []morph.ReflectOption{morph.WithPrimaryKeyColumns("id", "name")},
+			queryOptions:   []morph.QueryOption{morph.WithPlaceholder("$", true)},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.created_at, T.deleted_at, T.id, T.maybe_ignore, T.name, T.updated_at FROM test_models AS T WHERE (T.id, T.name) IN (($1, $2), ($3, $4));", query)
+			},
+		},
+		{
+			name:           "CompositeKey_WithExpandedTuples",
+			count:          2,
+			reflectOptions: []morph.ReflectOption{morph.WithPrimaryKeyColumns("id", "name")},
+			queryOptions:   []morph.QueryOption{morph.WithExpandedTuples()},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.created_at, T.deleted_at, T.id, T.maybe_ignore, T.name, T.updated_at FROM test_models AS T WHERE (T.id = ? AND T.name = ?) OR (T.id = ? AND T.name = ?);", query)
+			},
+		},
+		{
+			name:           "CompositeKey_WithPostgresDialect",
+			count:          2,
+			reflectOptions: []morph.ReflectOption{morph.WithPrimaryKeyColumns("id", "name")},
+			queryOptions:   morph.Dialects["postgres"],
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.created_at, T.deleted_at, T.id, T.maybe_ignore, T.name, T.updated_at FROM test_models AS T WHERE (T.id, T.name) IN (($1, $2), ($3, $4));", query)
+			},
+		},
+		{
+			name:           "CompositeKey_WithSQLServerDialect",
+			count:          2,
+			reflectOptions: []morph.ReflectOption{morph.WithPrimaryKeyColumns("id", "name")},
+			queryOptions:   morph.Dialects["sqlserver"],
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.created_at, T.deleted_at, T.id, T.maybe_ignore, T.name, T.updated_at FROM test_models AS T WHERE (T.id = @p1 AND T.name = @p2) OR (T.id = @p3 AND T.name = @p4);", query)
+			},
+		},
+		{
+			name:           "CompositeKey_WithMySQLDialect",
+			count:          1,
+			reflectOptions: []morph.ReflectOption{morph.WithPrimaryKeyColumns("id", "name")},
+			queryOptions:   morph.Dialects["mysql"],
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.created_at, T.deleted_at, T.id, T.maybe_ignore, T.name, T.updated_at FROM test_models AS T WHERE (T.id, T.name) IN ((?, ?));", query)
+			},
+		},
+		{
+			name:  "MissingKeys",
+			count: 0,
+			assertions: func(query string, err error) {
+				s.Require().ErrorIs(err, morph.ErrMissingKeys)
+				s.Empty(query)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// arrange.
+			var err error
+			s.sut, err = morph.Reflect(&TestModel{}, test.reflectOptions...)
+			if err != nil {
+				s.FailNow("unable to reflect in test", err)
+			}
+
+			// action.
+			query, err := s.sut.SelectByKeysQuery(test.count, test.queryOptions...)
+
+			// assert.
+			test.assertions(query, err)
+		})
+	}
+}
+
+func (s *TableTestSuite) TestTable_SelectByKeysQueryWithArgs() {
+	first, second := "first", "second"
+	tests := []struct {
+		name           string
+		keys           []any
+		reflectOptions []morph.ReflectOption
+		assertions     func(query string, args []any, err error)
+	}{
+		{
+			name: "Values",
+			keys: []any{1, 2},
+			assertions: func(query string, args []any, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.created_at, T.deleted_at, T.id, T.maybe_ignore, T.name, T.updated_at FROM test_models AS T WHERE T.id IN (?, ?);", query)
+				s.Equal([]any{1, 2}, args)
+			},
+		},
+		{
+			name: "Entities",
+			keys: []any{&TestModel{ID: 1}, TestModel{ID: 2}},
+			assertions: func(query string, args []any, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.created_at, T.deleted_at, T.id, T.maybe_ignore, T.name, T.updated_at FROM test_models AS T WHERE T.id IN (?, ?);", query)
+				s.Equal([]any{1, 2}, args)
+			},
+		},
+		{
+			name:           "CompositeKey",
+			keys:           []any{[]any{1, "first"}, &TestModel{ID: 2, Name: &second}},
+			reflectOptions: []morph.ReflectOption{morph.WithPrimaryKeyColumns("id", "name")},
+			assertions: func(query string, args []any, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.created_at, T.deleted_at, T.id, T.maybe_ignore, T.name, T.updated_at FROM test_models AS T WHERE (T.id, T.name) IN ((?, ?), (?, ?));", query)
+				s.Equal([]any{1, first, 2, second}, args)
+			},
+		},
+		{
+			name: "EntityOfAnotherType",
+			keys: []any{&TestModel{ID: 1}, struct{ ID int }{ID: 2}},
+			assertions: func(query string, args []any, err error) {
+				s.Require().ErrorIs(err, morph.ErrMismatchingTypeName)
+				s.Empty(query)
+				s.Empty(args)
+			},
+		},
+		{
+			name: "TimeValue",
+			keys: []any{time.Unix(0, 0).UTC()},
+			assertions: func(query string, args []any, err error) {
+				s.Require().NoError(err)
+				s.Equal([]any{time.Unix(0, 0).UTC()}, args)
+			},
+		},
+		{
+			name:           "CompositeKey_InvalidKey",
+			keys:           []any{1},
+			reflectOptions: []morph.ReflectOption{morph.WithPrimaryKeyColumns("id", "name")},
+			assertions: func(query string, args []any, err error) {
+				s.Require().ErrorIs(err, morph.ErrInvalidKey)
+				s.Empty(query)
+				s.Empty(args)
+			},
+		},
+		{
+			name: "MissingKeys",
+			keys: []any{},
+			assertions: func(query string, args []any, err error) {
+				s.Require().ErrorIs(err, morph.ErrMissingKeys)
+				s.Empty(query)
+				s.Empty(args)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// arrange.
+			var err error
+			s.sut, err = morph.Reflect(&TestModel{}, test.reflectOptions...)
+			if err != nil {
+				s.FailNow("unable to reflect in test", err)
+			}
+
+			// action.
+			query, args, err := s.sut.SelectByKeysQueryWithArgs(test.keys, morph.WithNamedParameters())
+
+			// assert.
+			test.assertions(query, args, err)
+		})
+	}
+}
+
+func (s *TableTestSuite) TestTable_SelectWhereQueryWithArgs() {
+	name := "fr33r"
+	var missing *string
+	tests := []struct {
+		name         string
+		criteria     morph.Criteria
+		order        []morph.Order
+		page         morph.Page
+		queryOptions []morph.QueryOption
+		assertions   func(query string, args []any, err error)
+	}{
+		{
+			name: "All",
+			assertions: func(query string, args []any, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.created_at, T.deleted_at, T.id, T.maybe_ignore, T.name, T.updated_at FROM test_models AS T WHERE 1=1;", query)
+				s.Empty(args)
+			},
+		},
+		{
+			name:     "Criteria",
+			criteria: morph.Criteria{"Name": &name, "ID": 1, "DeletedAt": nil},
+			assertions: func(query string, args []any, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.created_at, T.deleted_at, T.id, T.maybe_ignore, T.name, T.updated_at FROM test_models AS T WHERE 1=1 AND T.deleted_at IS NULL AND T.id = ? AND T.name = ?;", query)
+				s.Equal([]any{1, &name}, args)
+			},
+		},
+		{
+			name:         "Criteria_WithPostgresDialect",
+			criteria:     morph.Criteria{"Name": missing, "ID": 1, "UpdatedAt": "today"},
+			order:        []morph.Order{{Field: "UpdatedAt", Descending: true}, {Field: "ID"}},
+			page:         morph.Page{Limit: 10, Offset: 20},
+			queryOptions: morph.Dialects["postgres"],
+			assertions: func(query string, args []any, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.created_at, T.deleted_at, T.id, T.maybe_ignore, T.name, T.updated_at FROM test_models AS T WHERE 1=1 AND T.id = $1 AND T.name IS NULL AND T.updated_at = $2 ORDER BY T.updated_at DESC, T.id LIMIT 10 OFFSET 20;", query)
+				s.Equal([]any{1, "today"}, args)
+			},
+		},
+		{
+			name:         "Page_WithSQLServerDialect",
+			criteria:     morph.Criteria{"ID": 1},
+			page:         morph.Page{Limit: 10, Offset: 20},
+			queryOptions: morph.Dialects["sqlserver"],
+			assertions: func(query string, args []any, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.created_at, T.deleted_at, T.id, T.maybe_ignore, T.name, T.updated_at FROM test_models AS T WHERE 1=1 AND T.id = @p1 ORDER BY (SELECT NULL) OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY;", query)
+				s.Equal([]any{1}, args)
+			},
+		},
+		{
+			name:         "Offset_WithForUpdate",
+			order:        []morph.Order{{Field: "ID"}},
+			page:         morph.Page{Offset: 5},
+			queryOptions: []morph.QueryOption{morph.WithForUpdate(), morph.WithNamedParameters()},
+			assertions: func(query string, args []any, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.created_at, T.deleted_at, T.id, T.maybe_ignore, T.name, T.updated_at FROM test_models AS T WHERE 1=1 ORDER BY T.id OFFSET 5 FOR UPDATE;", query)
+				s.Empty(args)
+			},
+		},
+		{
+			name:     "MissingCriteriaField",
+			criteria: morph.Criteria{"Missing": 1},
+			assertions: func(query string, args []any, err error) {
+				s.Require().EqualError(err, `morph: no mapping for field "Missing"`)
+				s.Empty(query)
+			},
+		},
+		{
+			name:  "MissingOrderField",
+			order: []morph.Order{{Field: "Missing"}},
+			assertions: func(query string, args []any, err error) {
+				s.Require().EqualError(err, `morph: no mapping for field "Missing"`)
+				s.Empty(query)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// arrange.
+			var err error
+			s.sut, err = morph.Reflect(&TestModel{})
+			if err != nil {
+				s.FailNow("unable to reflect in test", err)
+			}
+
+			// action.
+			query, args, err := s.sut.SelectWhereQueryWithArgs(test.criteria, test.order, test.page, test.queryOptions...)
+
+			// assert.
+			test.assertions(query, args, err)
+		})
+	}
+}
+
+func (s *TableTestSuite) TestTable_MustSelectByKeysQuery_InvalidTable() {
+	// action + assert.
+	s.Panics(func() { s.sut.MustSelectByKeysQuery(1) })
+}
+
+func (s *TableTestSuite) TestTable_DeleteByKeysQuery() {
+	tests := []struct {
+		name           string
+		count          int
+		reflectOptions []morph.ReflectOption
+		queryOptions   []morph.QueryOption
+		assertions     func(query string, err error)
+	}{
+		{
+			name:  "SingleKey",
+			count: 2,
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("DELETE FROM test_models WHERE id IN (?, ?);", query)
+			},
+		},
+		{
+			name:           "CompositeKey",
+			count:          2,
+			reflectOptions: []morph.ReflectOption{morph.WithPrimaryKeyColumns("id", "name")},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("DELETE FROM test_models WHERE (id, name) IN ((?, ?), (?, ?));", query)
+			},
+		},
+		{
+			name:           "CompositeKey_WithExpandedTuples",
+			count:          1,
+			reflectOptions: []morph.ReflectOption{morph.WithPrimaryKeyColumns("id", "name")},
+			queryOptions:   []morph.QueryOption{morph.WithExpandedTuples(), morph.WithPlaceholder("$", true)},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("DELETE FROM test_models WHERE (id = $1 AND name = $2);", query)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// arrange.
+			var err error
+			s.sut, err = morph.Reflect(&TestModel{}, test.reflectOptions...)
+			if err != nil {
+				s.FailNow("unable to reflect in test", err)
+			}
+
+			// action.
+			query, err := s.sut.DeleteByKeysQuery(test.count, test.queryOptions...)
+
+			// assert.
+			test.assertions(query, err)
+		})
+	}
+}
+
+func (s *TableTestSuite) TestTable_DeleteByKeysQueryWithArgs() {
+	// arrange.
+	var err error
+	s.sut, err = morph.Reflect(&TestModel{})
+	if err != nil {
+		s.FailNow("unable to reflect in test", err)
+	}
+
+	// action.
+	query, args, err := s.sut.DeleteByKeysQueryWithArgs([]any{&TestModel{ID: 3}, 4}, morph.WithPlaceholder("$", true))
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal("DELETE FROM test_models WHERE id IN ($1, $2);", query)
+	s.Equal([]any{3, 4}, args)
+}
+
+func (s *TableTestSuite) TestTable_MustDeleteByKeysQuery_InvalidTable() {
+	// action + assert.
+	s.Panics(func() { s.sut.MustDeleteByKeysQuery(1) })
+}
+
+func (s *TableTestSuite) TestTable_SelectQuery_Locking() {
+	tests := []struct {
+		name         string
+		queryOptions []morph.QueryOption
+		assertions   func(query string, err error)
+	}{
+		{
+			name:         "WithForUpdate",
+			queryOptions: []morph.QueryOption{morph.WithForUpdate()},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.created_at, T.deleted_at, T.id, T.maybe_ignore, T.name, T.updated_at FROM test_models AS T WHERE 1=1 AND T.id = ? FOR UPDATE;", query)
+			},
+		},
+		{
+			name:         "WithForShare",
+			queryOptions: []morph.QueryOption{morph.WithForShare()},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.created_at, T.deleted_at, T.id, T.maybe_ignore, T.name, T.updated_at FROM test_models AS T WHERE 1=1 AND T.id = ? FOR SHARE;", query)
+			},
+		},
+		{
+			name:         "WithForUpdate_WithSkipLocked",
+			queryOptions: []morph.QueryOption{morph.WithForUpdate(), morph.WithSkipLocked()},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.created_at, T.deleted_at, T.id, T.maybe_ignore, T.name, T.updated_at FROM test_models AS T WHERE 1=1 AND T.id = ? FOR UPDATE SKIP LOCKED;", query)
+			},
+		},
+		{
+			name:         "WithForShare_WithNoWait",
+			queryOptions: []morph.QueryOption{morph.WithForShare(), morph.WithNoWait()},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.created_at, T.deleted_at, T.id, T.maybe_ignore, T.name, T.updated_at FROM test_models AS T WHERE 1=1 AND T.id = ? FOR SHARE NOWAIT;", query)
+			},
+		},
+		{
+			name:         "WithNoWait_WithoutLock",
+			queryOptions: []morph.QueryOption{morph.WithNoWait()},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.created_at, T.deleted_at, T.id, T.maybe_ignore, T.name, T.updated_at FROM test_models AS T WHERE 1=1 AND T.id = ?;", query)
+			},
+		},
+		{
+			name:         "WithUpdateLockHints",
+			queryOptions: []morph.QueryOption{morph.WithUpdateLockHints(), morph.WithPlaceholder("@p", true)},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("SELECT T.created_at, T.deleted_at, T.id, T.maybe_ignore, T.name, T.updated_at FROM test_models AS T WITH (UPDLOCK, ROWLOCK) WHERE 1=1 AND T.id = @p1;", query)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// arrange.
+			var err error
+			s.sut, err = morph.Reflect(&TestModel{})
+			if err != nil {
+				s.FailNow("unable to reflect in test", err)
+			}
+
+			// action.
+			query, err := s.sut.SelectQuery(test.queryOptions...)
+
+			// assert.
+			test.assertions(query, err)
+		})
+	}
+}
+
+func (s *TableTestSuite) TestTable_SelectQueryWithArgs_Locking() {
+	// arrange.
+	model := TestModel{ID: 1}
+
+	var err error
+	s.sut, err = morph.Reflect(&model)
+	if err != nil {
+		s.FailNow("unable to reflect in test", err)
+	}
+
+	// action.
+	query, args, err := s.sut.SelectQueryWithArgs(&model, morph.WithPlaceholder("$", true), morph.WithForUpdate(), morph.WithSkipLocked())
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal("SELECT T.created_at, T.deleted_at, T.id, T.maybe_ignore, T.name, T.updated_at FROM test_models AS T WHERE 1=1 AND T.id = $1 FOR UPDATE SKIP LOCKED;", query)
+	s.Equal([]any{model.ID}, args)
+}
+
+func (s *TableTestSuite) TestTable_Snapshot() {
+	// arrange.
+	name := "test"
+	model := TestModel{ID: 1, Name: &name}
+
+	var err error
+	s.sut, err = morph.Reflect(&model)
+	if err != nil {
+		s.FailNow("unable to reflect in test", err)
+	}
+
+	// action.
+	snapshot, err := s.sut.Snapshot(&model)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal(model.ID, snapshot["id"])
+	s.Equal(name, snapshot["name"])
+}
+
+func (s *TableTestSuite) TestTable_Snapshot_CopiesSlicesAndMaps() {
+	// arrange.
+	type Document struct {
+		ID     int
+		Body   []byte
+		Tags   []string
+		Labels map[string][]string
+	}
+	doc := Document{ID: 1, Body: []byte("draft"), Tags: []string{"a"}, Labels: map[string][]string{"env": {"dev"}}}
+	table, err := morph.Reflect(&doc)
+	s.Require().NoError(err)
+
+	// action.
+	snapshot, err := table.Snapshot(&doc)
+	doc.Body[0] = 'D'
+	doc.Tags[0] = "b"
+	doc.Labels["env"][0] = "prod"
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal([]byte("draft"), snapshot["body"])
+	s.Equal([]string{"a"}, snapshot["tags"])
+	s.Equal(map[string][]string{"env": {"dev"}}, snapshot["labels"])
+	s.Equal([]string{"body", "labels", "tags"}, table.MustEvaluate(&doc).Changes(snapshot))
+}
+
+func (s *TableTestSuite) TestTable_UpdateChangedQueryWithArgs() {
+	tests := []struct {
+		name         string
+		queryOptions []morph.QueryOption
+		mutation     func(*TestModel)
+		assertions   func(obj TestModel, query string, args []any, err error)
+	}{
+		{
+			name: "SingleChange",
+			mutation: func(m *TestModel) {
+				m.MaybeIgnore = true
+			},
+			assertions: func(obj TestModel, query string, args []any, err error) {
+				s.Require().NoError(err)
+				s.Equal("UPDATE test_models AS T SET T.maybe_ignore = ? WHERE 1=1 AND T.id = ?;", query)
+				s.Equal([]any{true, obj.ID}, args)
+			},
+		},
+		{
+			name:         "ChangeToNil",
+			queryOptions: []morph.QueryOption{morph.WithPlaceholder("$", true)},
+			mutation: func(m *TestModel) {
+				m.Name = nil
+				m.UpdatedAt = m.UpdatedAt.Add(time.Hour)
+			},
+			assertions: func(obj TestModel, query string, args []any, err error) {
+				s.Require().NoError(err)
+				s.Equal("UPDATE test_models AS T SET T.name = $1, T.updated_at = $2 WHERE 1=1 AND T.id = $3;", query)
+				s.Equal([]any{nil, obj.UpdatedAt, obj.ID}, args)
+			},
+		},
+		{
+			name: "ChangeFromNil",
+			mutation: func(m *TestModel) {
+				deletedAt := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+				m.DeletedAt = &deletedAt
+			},
+			assertions: func(obj TestModel, query string, args []any, err error) {
+				s.Require().NoError(err)
+				s.Equal("UPDATE test_models AS T SET T.deleted_at = ? WHERE 1=1 AND T.id = ?;", query)
+				s.Equal([]any{*obj.DeletedAt, obj.ID}, args)
+			},
+		},
+		{
+			name:     "NoChanges",
+			mutation: func(m *TestModel) {},
+			assertions: func(obj TestModel, query string, args []any, err error) {
+				s.Require().ErrorIs(err, morph.ErrNoChanges)
+				s.Empty(query)
+				s.Empty(args)
+			},
+		},
+		{
+			name: "PrimaryKeyChangeOnly",
+			mutation: func(m *TestModel) {
+				m.ID = 2
+			},
+			assertions: func(obj TestModel, query string, args []any, err error) {
+				s.Require().ErrorIs(err, morph.ErrNoChanges)
+				s.Empty(query)
+				s.Empty(args)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// arrange.
+			name := "test"
+			model := TestModel{
+				ID:        1,
+				Name:      &name,
+				UpdatedAt: time.Date(2024, time.February, 28, 10, 30, 0, 0, time.UTC),
+			}
+
+			var err error
+			s.sut, err = morph.Reflect(&model)
+			if err != nil {
+				s.FailNow("unable to reflect in test", err)
+			}
+			snapshot, err := s.sut.Snapshot(&model)
+			if err != nil {
+				s.FailNow("unable to snapshot in test", err)
+			}
+			test.mutation(&model)
+
+			// action.
+			query, args, err := s.sut.UpdateChangedQueryWithArgs(snapshot, &model, test.queryOptions...)
+
+			// assert.
+			test.assertions(model, query, args, err)
+		})
+	}
+}
+
+func (s *TableTestSuite) TestTable_UpdateChangedQueryWithArgs_InvalidTable() {
+	// action.
+	query, args, err := s.sut.UpdateChangedQueryWithArgs(morph.EvaluationResult{}, &TestModel{})
+
+	// assert.
+	s.Error(err)
+	s.Empty(query)
+	s.Empty(args)
+}
+
+func (s *TableTestSuite) TestTable_EvaluationResults_Changes() {
+	// arrange.
+	at := time.Date(2024, time.February, 28, 10, 30, 0, 0, time.UTC)
+	snapshot := morph.EvaluationResult{"id": 1, "title": "before", "description": nil, "at": at}
+	result := morph.EvaluationResult{"id": 1, "title": "after", "description": "added", "at": at.In(time.Local)}
+
+	// action.
+	changes := result.Changes(snapshot)
+
+	// assert.
+	s.Equal([]string{"description", "title"}, changes)
+}
+
+func (s *TableTestSuite) TestTable_InsertQuery_WithoutEmptyValues() {
+	tests := []struct {
+		name         string
+		queryOptions func(*TestModel) []morph.QueryOption
+		preparations func() TestModel
+		assertions   func(query string, err error)
+	}{
+		{
+			name: "EmptyIfNil",
+			queryOptions: func(m *TestModel) []morph.QueryOption {
+				return []morph.QueryOption{morph.WithoutEmptyValues(m)}
+			},
+			preparations: func() TestModel {
+				return TestModel{ID: 1}
+			},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("INSERT INTO test_models (created_at, id, maybe_ignore, updated_at) VALUES (?, ?, ?, ?);", query)
+			},
+		},
+		{
+			name: "EmptyIfZero",
+			queryOptions: func(m *TestModel) []morph.QueryOption {
+				return []morph.QueryOption{morph.WithoutZeroValues(m), morph.WithPlaceholder("$", true)}
+			},
+			preparations: func() TestModel {
+				name := "test"
+				return TestModel{Name: &name}
+			},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("INSERT INTO test_models (created_at, name) VALUES ($1, $2);", query)
+			},
+		},
+		{
+			name: "CustomPredicate",
+			queryOptions: func(m *TestModel) []morph.QueryOption {
+				return []morph.QueryOption{
+					morph.WithoutEmptyValues(m),
+					morph.WithEmptyPredicate(func(c morph.Column, v any) bool {
+						return c.PrimaryKey() || v == nil
+					}),
+				}
+			},
+			preparations: func() TestModel {
+				return TestModel{ID: 1}
+			},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("INSERT INTO test_models (created_at, maybe_ignore, updated_at) VALUES (?, ?, ?);", query)
+			},
+		},
+		{
+			name: "AllColumnsOmitted",
+			queryOptions: func(m *TestModel) []morph.QueryOption {
+				return []morph.QueryOption{
+					morph.WithoutEmptyValues(m),
+					morph.WithEmptyPredicate(func(morph.Column, any) bool { return true }),
+				}
+			},
+			preparations: func() TestModel {
+				return TestModel{ID: 1}
+			},
+			assertions: func(query string, err error) {
+				s.Require().ErrorIs(err, morph.ErrAllColumnsOmitted)
+				s.Empty(query)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// arrange.
+			model := test.preparations()
+
+			var err error
+			s.sut, err = morph.Reflect(&model)
+			if err != nil {
+				s.FailNow("unable to reflect in test", err)
+			}
+
+			// action.
+			query, err := s.sut.InsertQuery(test.queryOptions(&model)...)
+
+			// assert.
+			test.assertions(query, err)
+		})
+	}
+}
+
+func (s *TableTestSuite) TestTable_InsertQueryWithArgs_WithoutEmptyValues() {
+	// arrange.
+	model := TestModel{ID: 1}
+
+	var err error
+	s.sut, err = morph.Reflect(&model)
+	if err != nil {
+		s.FailNow("unable to reflect in test", err)
+	}
+
+	// action.
+	query, args, err := s.sut.InsertQueryWithArgs(&model, morph.WithoutEmptyValues(&model))
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal("INSERT INTO test_models (created_at, id, maybe_ignore, updated_at) VALUES (?, ?, ?, ?);", query)
+	s.Equal([]any{model.CreatedAt(), model.ID, model.MaybeIgnore, model.UpdatedAt}, args)
+}
+
+func (s *TableTestSuite) TestTable_UpdateQuery_WithoutEmptyValues() {
+	tests := []struct {
+		name         string
+		queryOptions func(*AnotherTestModel) []morph.QueryOption
+		preparations func() AnotherTestModel
+		assertions   func(query string, err error)
+	}{
+		{
+			name: "FirstColumnOmitted",
+			queryOptions: func(m *AnotherTestModel) []morph.QueryOption {
+				return []morph.QueryOption{morph.WithoutEmptyValues(m)}
+			},
+			preparations: func() AnotherTestModel {
+				return AnotherTestModel{ID: 1, Title: "another"}
+			},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("UPDATE another_test_models AS A SET A.title = ? WHERE 1=1 AND A.id = ?;", query)
+			},
+		},
+		{
+			name: "EmptyIfZero",
+			queryOptions: func(m *AnotherTestModel) []morph.QueryOption {
+				return []morph.QueryOption{morph.WithoutZeroValues(m), morph.WithPlaceholder("$", true)}
+			},
+			preparations: func() AnotherTestModel {
+				description := "description"
+				return AnotherTestModel{ID: 1, Description: &description}
+			},
+			assertions: func(query string, err error) {
+				s.Require().NoError(err)
+				s.Equal("UPDATE another_test_models AS A SET A.description = $1 WHERE 1=1 AND A.id = $2;", query)
+			},
+		},
+		{
+			name: "AllColumnsOmitted",
+			queryOptions: func(m *AnotherTestModel) []morph.QueryOption {
+				return []morph.QueryOption{morph.WithoutZeroValues(m)}
+			},
+			preparations: func() AnotherTestModel {
+				return AnotherTestModel{ID: 1}
+			},
+			assertions: func(query string, err error) {
+				s.Require().ErrorIs(err, morph.ErrAllColumnsOmitted)
+				s.Empty(query)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// arrange.
+			model := test.preparations()
+
+			var err error
+			s.sut, err = morph.Reflect(&model)
+			if err != nil {
+				s.FailNow("unable to reflect in test", err)
+			}
+
+			// action.
+			query, err := s.sut.UpdateQuery(test.queryOptions(&model)...)
+
+			// assert.
+			test.assertions(query, err)
+		})
+	}
+}
+
+func (s *TableTestSuite) TestTable_AsConfiguration() {
+	// arrange.
+	var err error
+	s.sut, err = morph.Reflect(&AnotherTestModel{})
+	if err != nil {
+		s.FailNow("unable to reflect in test", err)
+	}
+
+	// action.
+	config := s.sut.AsConfiguration()
+
+	// assert.
+	s.Equal(morph.TableConfiguration{
+		TypeName: "*morph_test.AnotherTestModel",
+		Name:     "another_test_models",
+		Alias:    "A",
+		Columns: []morph.ColumnConfiguration{
+			{Name: "description", Field: "Description", FieldType: "*string", FieldStrategy: morph.FieldStrategyStructField},
+			{Name: "id", Field: "ID", FieldType: "int", FieldStrategy: morph.FieldStrategyStructField, PrimaryKey: true},
+			{Name: "title", Field: "Title", FieldType: "string", FieldStrategy: morph.FieldStrategyStructField},
+		},
+	}, config)
+}
+
+func (s *TableTestSuite) TestTable_QueryWithArgs_TimePointer() {
+	deletedAt := time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC)
+	name := "test"
+	model := TestModel{ID: 1, Name: &name, DeletedAt: &deletedAt}
+
+	tests := []struct {
+		name     string
+		action   func(t morph.Table) (string, []any, error)
+		expected []any
+	}{
+		{
+			name: "Insert",
+			action: func(t morph.Table) (string, []any, error) {
+				return t.InsertQueryWithArgs(&model)
+			},
+			expected: []any{model.CreatedAt(), deletedAt, model.ID, name, model.MaybeIgnore, model.UpdatedAt},
+		},
+		{
+			name: "Update",
+			action: func(t morph.Table) (string, []any, error) {
+				return t.UpdateQueryWithArgs(&model)
+			},
+			expected: []any{model.CreatedAt(), deletedAt, model.ID, name, model.MaybeIgnore, model.UpdatedAt},
+		},
+		{
+			name: "Select",
+			action: func(t morph.Table) (string, []any, error) {
+				return t.SelectQueryWithArgs(&model)
+			},
+			expected: []any{model.ID},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// arrange.
+			table, err := morph.Reflect(&model)
+			s.Require().NoError(err)
+
+			// action.
+			_, args, err := test.action(table)
+
+			// assert.
+			s.Require().NoError(err)
+			s.ElementsMatch(test.expected, args)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+table {
+  typeName = "example.User"
+  name     = "user"
+  alias    = "U"
+
+  column {
+    name          = "uuid"
+    field         = "UUID"
+    fieldType     = "string"
+    fieldStrategy = "method"
+    primaryKey    = true
+  }
+
+  column {
+    name          = "username"
+    field         = "Username"
+    fieldType     = "string"
+    fieldStrategy = "method"
+    primaryKey    = false
+  }
+
+  column {
+    name          = "password"
+    field         = "Password"
+    fieldType     = "string"
+    fieldStrategy = "method"
+    primaryKey    = false
+  }
+
+  column {
+    name          = "givenName"
+    field         = "GivenName"
+    fieldType     = "string"
+    fieldStrategy = "method"
+    primaryKey    = false
+  }
+
+  column {
+    name          = "surname"
+    field         = "Surname"
+    fieldType     = "string"
+    fieldStrategy = "method"
+    primaryKey    = false
+  }
+}
@@ -0,0 +1,113 @@
+package morph
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Defines the various errors that can occur when verifying configurations against types.
+var (
+	// ErrMissingField represents an error encountered when a column refers to a struct field
+	// that does not exist or is not exported on the type.
+	ErrMissingField = errors.New("morph: field must be an exported struct field of the type")
+
+	// ErrMissingMethod represents an error encountered when a column refers to a method
+	// that does not exist on the type.
+	ErrMissingMethod = errors.New("morph: field must be a method of the type")
+
+	// ErrInvalidMethodSignature represents an error encountered when a column refers to a method
+	// that does not accept zero arguments and return a single value.
+	ErrInvalidMethodSignature = errors.New("morph: method must accept no arguments and return a single value")
+
+	// ErrMismatchingFieldType represents an error encountered when the field type of a column
+	// does not match the type of the struct field or method return value.
+	ErrMismatchingFieldType = errors.New("morph: field type must match the type")
+
+	// ErrUnmappedType represents an error encountered when a sample type is not mapped by any table.
+	ErrUnmappedType = errors.New("morph: type must be mapped by a table")
+)
+
+// Verify ensures that the tables within the provided configuration match the Go types of the
+// provided samples, reporting every problem found as a ValidationErrors. Tables are matched
+// to samples by type name, and tables without a sample are not verified.
+func Verify(c Configuration, samples ...any) error {
+	var errs ValidationErrors
+	for _, sample := range samples {
+		t := reflect.TypeOf(sample)
+		if t == nil {
+			continue
+		}
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		pt := reflect.PointerTo(t)
+
+		mapped := false
+		for i, table := range c.Tables {
+			typeName := strings.TrimSpace(table.TypeName)
+			if typeName != t.String() && typeName != pt.String() {
+				continue
+			}
+			mapped = true
+
+			for j, col := range table.Columns {
+				if err := verifyColumn(t, pt, col); err != nil {
+					errs = append(errs, &ValidationError{
+						Table:    table.label(i),
+						Column:   col.label(j),
+						Position: c.Position(columnPath(i, j)),
+						Err:      err,
+					})
+				}
+			}
+		}
+
+		if !mapped {
+			errs = append(errs, &ValidationError{
+				Err: fmt.Errorf("%w: %s", ErrUnmappedType, t.String()),
+			})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// verifyColumn ensures that the provided column matches the type or pointer type provided.
+// Columns using the struct field strategy can only match struct types.
+func verifyColumn(t, pt reflect.Type, col ColumnConfiguration) error {
+	name := strings.TrimSpace(col.Field)
+	var actual reflect.Type
+	switch col.FieldStrategy {
+	case FieldStrategyStructField:
+		if t.Kind() != reflect.Struct {
+			return fmt.Errorf("%w: %s", ErrNotStruct, t.String())
+		}
+		field, ok := t.FieldByName(name)
+		if !ok || !field.IsExported() {
+			return ErrMissingField
+		}
+		actual = field.Type
+	case FieldStrategyMethod:
+		method, ok := pt.MethodByName(name)
+		if !ok {
+			return ErrMissingMethod
+		}
+		if method.Type.NumIn() != 1 || method.Type.NumOut() != 1 {
+			return ErrInvalidMethodSignature
+		}
+		actual = method.Type.Out(0)
+	default:
+		return ErrInvalidFieldStrategy
+	}
+
+	expected := strings.TrimSpace(col.FieldType)
+	if expected != "" && expected != actual.String() {
+		return fmt.Errorf("%w: expected %s, found %s", ErrMismatchingFieldType, expected, actual.String())
+	}
+	return nil
+}
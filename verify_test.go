@@ -0,0 +1,182 @@
+package morph_test
+
+import (
+	"testing"
+
+	"github.com/freerware/morph"
+	"github.com/stretchr/testify/suite"
+)
+
+type VerifyTestSuite struct {
+	suite.Suite
+}
+
+func TestVerifyTestSuite(t *testing.T) {
+	suite.Run(t, new(VerifyTestSuite))
+}
+
+// TestModels is a named slice type, which cannot have struct fields.
+type TestModels []TestModel
+
+func (m TestModels) Len() int {
+	return len(m)
+}
+
+func (s *VerifyTestSuite) TestVerify() {
+	configuration := func(columns ...morph.ColumnConfiguration) morph.Configuration {
+		return morph.Configuration{
+			Tables: []morph.TableConfiguration{
+				{
+					TypeName: "*morph_test.TestModel",
+					Name:     "test_models",
+					Alias:    "T",
+					Columns: append([]morph.ColumnConfiguration{
+						{Name: "id", Field: "ID", FieldType: "int", FieldStrategy: morph.FieldStrategyStructField, PrimaryKey: true},
+					}, columns...),
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		config  morph.Configuration
+		samples []any
+		errs    []error
+	}{
+		{
+			name: "Valid",
+			config: configuration(
+				morph.ColumnConfiguration{Name: "name", Field: "Name", FieldType: "*string", FieldStrategy: morph.FieldStrategyStructField},
+				morph.ColumnConfiguration{Name: "created_at", Field: "CreatedAt", FieldType: "time.Time", FieldStrategy: morph.FieldStrategyMethod},
+				morph.ColumnConfiguration{Name: "another_ptr", Field: "AnotherPtr", FieldStrategy: morph.FieldStrategyMethod},
+			),
+			samples: []any{TestModel{}},
+		},
+		{
+			name: "Valid_PointerSample",
+			config: configuration(
+				morph.ColumnConfiguration{Name: "deleted_at", Field: "DeletedAt", FieldType: "*time.Time", FieldStrategy: morph.FieldStrategyStructField},
+			),
+			samples: []any{&TestModel{}},
+		},
+		{
+			name: "MissingField",
+			config: configuration(
+				morph.ColumnConfiguration{Name: "title", Field: "Title", FieldStrategy: morph.FieldStrategyStructField},
+			),
+			samples: []any{TestModel{}},
+			errs:    []error{morph.ErrMissingField},
+		},
+		{
+			name: "MissingMethod",
+			config: configuration(
+				morph.ColumnConfiguration{Name: "name", Field: "Name", FieldStrategy: morph.FieldStrategyMethod},
+			),
+			samples: []any{TestModel{}},
+			errs:    []error{morph.ErrMissingMethod},
+		},
+		{
+			name: "InvalidMethodSignature",
+			config: configuration(
+				morph.ColumnConfiguration{Name: "set_name", Field: "SetName", FieldStrategy: morph.FieldStrategyMethod},
+				morph.ColumnConfiguration{Name: "ignored", Field: "Ignored", FieldStrategy: morph.FieldStrategyMethod},
+			),
+			samples: []any{TestModel{}},
+			errs:    []error{morph.ErrInvalidMethodSignature, morph.ErrInvalidMethodSignature},
+		},
+		{
+			name: "MismatchingFieldType",
+			config: configuration(
+				morph.ColumnConfiguration{Name: "name", Field: "Name", FieldType: "string", FieldStrategy: morph.FieldStrategyStructField},
+				morph.ColumnConfiguration{Name: "created_at", Field: "CreatedAt", FieldType: "*time.Time", FieldStrategy: morph.FieldStrategyMethod},
+			),
+			samples: []any{TestModel{}},
+			errs:    []error{morph.ErrMismatchingFieldType, morph.ErrMismatchingFieldType},
+		},
+		{
+			name: "UnexportedField",
+			config: morph.Configuration{
+				Tables: []morph.TableConfiguration{
+					{
+						TypeName: "morph_test.AnotherTestModel",
+						Columns: []morph.ColumnConfiguration{
+							{Name: "secret", Field: "secret", FieldStrategy: morph.FieldStrategyStructField},
+						},
+					},
+				},
+			},
+			samples: []any{AnotherTestModel{}},
+			errs:    []error{morph.ErrMissingField},
+		},
+		{
+			name: "NotStruct",
+			config: morph.Configuration{
+				Tables: []morph.TableConfiguration{
+					{
+						TypeName: "morph_test.TestModels",
+						Name:     "test_models",
+						Alias:    "T",
+						Columns: []morph.ColumnConfiguration{
+							{Name: "id", Field: "ID", FieldStrategy: morph.FieldStrategyStructField, PrimaryKey: true},
+							{Name: "count", Field: "Len", FieldType: "int", FieldStrategy: morph.FieldStrategyMethod},
+						},
+					},
+				},
+			},
+			samples: []any{TestModels{}},
+			errs:    []error{morph.ErrNotStruct},
+		},
+		{
+			name:    "UnmappedType",
+			config:  configuration(),
+			samples: []any{AnotherTestModel{}},
+			errs:    []error{morph.ErrUnmappedType},
+		},
+		{
+			name: "NoSamples",
+			config: configuration(
+				morph.ColumnConfiguration{Name: "title", Field: "Title", FieldStrategy: morph.FieldStrategyStructField},
+			),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// action.
+			err := morph.Verify(test.config, test.samples...)
+
+			// assert.
+			if len(test.errs) == 0 {
+				s.Require().NoError(err)
+				return
+			}
+			var errs morph.ValidationErrors
+			s.Require().ErrorAs(err, &errs)
+			s.Require().Len(errs, len(test.errs))
+			for i, expected := range test.errs {
+				s.ErrorIs(errs[i], expected)
+			}
+		})
+	}
+}
+
+func (s *VerifyTestSuite) TestVerify_Message() {
+	// arrange.
+	config, err := morph.YAMLLoader{}.LoadBytes([]byte(`tables:
+  - typeName: morph_test.TestModel
+    name: test_models
+    columns:
+      - name: name
+        field: Name
+        fieldType: string
+        fieldStrategy: struct_field
+`))
+	s.Require().NoError(err)
+
+	// action.
+	err = morph.Verify(config, TestModel{})
+
+	// assert.
+	s.EqualError(err, `morph: 5:9: table "test_models", column "name": field type must match the type: expected string, found *string`)
+}
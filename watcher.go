@@ -0,0 +1,248 @@
+package morph
+
+import (
+	"crypto/sha256"
+	"errors"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultPollInterval is the default interval at which watched files are checked for changes.
+const DefaultPollInterval = 2 * time.Second
+
+var (
+	// ErrWatcherClosed represents an error encountered when reloading a closed watcher.
+	ErrWatcherClosed = errors.New("morph: watcher is closed")
+
+	// ErrInvalidPollInterval represents an error encountered when a watcher is constructed
+	// with a poll interval that is not positive.
+	ErrInvalidPollInterval = errors.New("morph: poll interval must be positive")
+)
+
+// WatchEvent represents the outcome of reloading the watched files.
+type WatchEvent struct {
+	// Tables are the tables produced by the changed files. They are nil when Err is set,
+	// in which case the watcher continues to serve the previous tables.
+	Tables []Table
+
+	// Err is the error encountered when loading or validating the changed files.
+	Err error
+}
+
+// WatcherOption is a function that configures the watcher options.
+type WatcherOption func(*WatcherOptions)
+
+// WatcherOptions represents the options used when watching configuration files.
+type WatcherOptions struct {
+	PollInterval time.Duration
+}
+
+// WithPollInterval checks the watched files for changes at the provided interval, which
+// must be positive.
+func WithPollInterval(interval time.Duration) WatcherOption {
+	return func(o *WatcherOptions) {
+		o.PollInterval = interval
+	}
+}
+
+// Watcher watches configuration files for changes, and atomically swaps the current
+// tables when the changed files produce valid tables. Files are polled, so no external
+// service is needed; only the watched files themselves are checked for changes, not
+// the fragments they include.
+type Watcher struct {
+	paths   []string
+	options WatcherOptions
+	current atomic.Value
+
+	// reloadMu serializes reloads, and guards the checksums and error of the last reload.
+	reloadMu sync.Mutex
+	sums     [][sha256.Size]byte
+	err      error
+
+	// mu guards the subscribers and the closed state.
+	mu      sync.Mutex
+	subs    []chan WatchEvent
+	closed  bool
+	done    chan struct{}
+	stopped chan struct{}
+}
+
+// NewWatcher loads and merges the provided files in the order provided, and then watches
+// them for changes. See Merge for how the configurations are combined. It fails when the
+// poll interval is not positive, or when the files cannot be loaded or do not produce valid
+// tables.
+func NewWatcher(paths []string, options ...WatcherOption) (*Watcher, error) {
+	w := &Watcher{
+		paths:   append([]string(nil), paths...),
+		options: WatcherOptions{PollInterval: DefaultPollInterval},
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	for _, opt := range options {
+		opt(&w.options)
+	}
+	if w.options.PollInterval <= 0 {
+		return nil, ErrInvalidPollInterval
+	}
+
+	sums, err := w.checksums()
+	if err != nil {
+		return nil, err
+	}
+	tables, err := w.load()
+	if err != nil {
+		return nil, err
+	}
+	w.sums = sums
+	w.current.Store(tables)
+
+	go w.poll()
+	return w, nil
+}
+
+// Current retrieves the most recent valid tables.
+func (w *Watcher) Current() []Table {
+	return w.current.Load().([]Table)
+}
+
+// Subscribe retrieves a channel that receives an event each time the watched files change.
+// Subscribers that fall behind only receive the most recent event. The channel is closed
+// when the watcher is closed.
+func (w *Watcher) Subscribe() <-chan WatchEvent {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	ch := make(chan WatchEvent, 1)
+	if w.closed {
+		close(ch)
+		return ch
+	}
+	w.subs = append(w.subs, ch)
+	return ch
+}
+
+// Reload checks the watched files for changes immediately, rather than waiting for the
+// next poll. It returns the error encountered when the watched files cannot be loaded
+// or do not produce valid tables. Subscribers are only notified of changes, so an error
+// is reported to them once until the files change again.
+func (w *Watcher) Reload() error {
+	w.reloadMu.Lock()
+	defer w.reloadMu.Unlock()
+
+	w.mu.Lock()
+	closed := w.closed
+	w.mu.Unlock()
+	if closed {
+		return ErrWatcherClosed
+	}
+
+	sums, err := w.checksums()
+	if err != nil {
+		if w.sums != nil {
+			w.sums, w.err = nil, err
+			w.publish(WatchEvent{Err: err})
+		}
+		return err
+	}
+	if w.unchanged(sums) {
+		return w.err
+	}
+
+	w.sums = sums
+	tables, err := w.load()
+	if w.err = err; err != nil {
+		w.publish(WatchEvent{Err: err})
+		return err
+	}
+
+	w.current.Store(tables)
+	w.publish(WatchEvent{Tables: tables})
+	return nil
+}
+
+// Close stops watching the files and closes the subscriber channels.
+func (w *Watcher) Close() error {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return nil
+	}
+	w.closed = true
+	close(w.done)
+	w.mu.Unlock()
+
+	<-w.stopped
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, ch := range w.subs {
+		close(ch)
+	}
+	w.subs = nil
+	return nil
+}
+
+// poll periodically reloads the watched files until the watcher is closed.
+func (w *Watcher) poll() {
+	defer close(w.stopped)
+	ticker := time.NewTicker(w.options.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.done:
+			return
+		case <-ticker.C:
+			w.Reload()
+		}
+	}
+}
+
+// load loads, merges and validates the watched files.
+func (w *Watcher) load() ([]Table, error) {
+	c, err := loadAll(w.paths)
+	if err != nil {
+		return nil, err
+	}
+	return c.AsMetadataStrict()
+}
+
+// checksums retrieves the checksums of the contents of the watched files.
+func (w *Watcher) checksums() ([][sha256.Size]byte, error) {
+	sums := make([][sha256.Size]byte, len(w.paths))
+	for i, path := range w.paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		sums[i] = sha256.Sum256(data)
+	}
+	return sums, nil
+}
+
+// unchanged determines if the provided checksums match those of the last reload.
+func (w *Watcher) unchanged(sums [][sha256.Size]byte) bool {
+	if len(sums) != len(w.sums) {
+		return false
+	}
+	for i := range sums {
+		if sums[i] != w.sums[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// publish sends the provided event to the subscribers, replacing any event that
+// a subscriber has yet to receive.
+func (w *Watcher) publish(event WatchEvent) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, ch := range w.subs {
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- event
+	}
+}
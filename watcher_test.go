@@ -0,0 +1,222 @@
+package morph_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/freerware/morph"
+	"github.com/stretchr/testify/suite"
+)
+
+const watchedUsers = `tables:
+  - typeName: example.User
+    name: %s
+    alias: U
+    columns:
+      - name: id
+        field: ID
+        fieldStrategy: struct_field
+        primaryKey: true
+      - name: username
+        field: Username
+        fieldStrategy: struct_field
+`
+
+type WatcherTestSuite struct {
+	suite.Suite
+
+	path string
+}
+
+func TestWatcherTestSuite(t *testing.T) {
+	suite.Run(t, new(WatcherTestSuite))
+}
+
+func (s *WatcherTestSuite) SetupTest() {
+	s.path = filepath.Join(s.T().TempDir(), "users.yaml")
+	s.write("users")
+}
+
+func (s *WatcherTestSuite) write(name string) {
+	s.writeRaw(fmt.Sprintf(watchedUsers, name))
+}
+
+func (s *WatcherTestSuite) writeRaw(contents string) {
+	s.Require().NoError(os.WriteFile(s.path, []byte(contents), 0o600))
+}
+
+func (s *WatcherTestSuite) watch() *morph.Watcher {
+	w, err := morph.NewWatcher([]string{s.path}, morph.WithPollInterval(time.Hour))
+	s.Require().NoError(err)
+	s.T().Cleanup(func() { w.Close() })
+	return w
+}
+
+func (s *WatcherTestSuite) TestNewWatcher() {
+	// action.
+	w := s.watch()
+
+	// assert.
+	s.Require().Len(w.Current(), 1)
+	s.Equal("users", w.Current()[0].Name())
+}
+
+func (s *WatcherTestSuite) TestNewWatcher_Invalid() {
+	// arrange.
+	s.write("")
+
+	// action.
+	_, err := morph.NewWatcher([]string{s.path})
+
+	// assert.
+	s.Require().ErrorIs(err, morph.ErrMissingTableName)
+}
+
+func (s *WatcherTestSuite) TestNewWatcher_InvalidPollInterval() {
+	for _, interval := range []time.Duration{0, -time.Second} {
+		s.Run(interval.String(), func() {
+			// action.
+			_, err := morph.NewWatcher([]string{s.path}, morph.WithPollInterval(interval))
+
+			// assert.
+			s.ErrorIs(err, morph.ErrInvalidPollInterval)
+		})
+	}
+}
+
+func (s *WatcherTestSuite) TestNewWatcher_MissingFile() {
+	// action.
+	_, err := morph.NewWatcher([]string{s.path + ".missing"})
+
+	// assert.
+	s.Require().Error(err)
+}
+
+func (s *WatcherTestSuite) TestReload() {
+	// arrange.
+	w := s.watch()
+	events := w.Subscribe()
+	previous := w.Current()
+	s.write("staging.users")
+
+	// action.
+	err := w.Reload()
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal("staging.users", w.Current()[0].Name())
+	s.Equal("users", previous[0].Name())
+	event := <-events
+	s.Require().NoError(event.Err)
+	s.Equal(w.Current(), event.Tables)
+}
+
+func (s *WatcherTestSuite) TestReload_Unchanged() {
+	// arrange.
+	w := s.watch()
+	events := w.Subscribe()
+
+	// action.
+	err := w.Reload()
+
+	// assert.
+	s.Require().NoError(err)
+	s.Len(events, 0)
+}
+
+func (s *WatcherTestSuite) TestReload_Invalid() {
+	// arrange.
+	w := s.watch()
+	events := w.Subscribe()
+	s.write("")
+
+	// action.
+	err := w.Reload()
+
+	// assert.
+	s.Require().ErrorIs(err, morph.ErrMissingTableName)
+	s.Equal("users", w.Current()[0].Name())
+	event := <-events
+	s.ErrorIs(event.Err, morph.ErrMissingTableName)
+	s.Nil(event.Tables)
+
+	// the error is reported to subscribers once, and the watcher recovers once fixed.
+	s.Require().ErrorIs(w.Reload(), morph.ErrMissingTableName)
+	s.Len(events, 0)
+	s.write("people")
+	s.Require().NoError(w.Reload())
+	s.Equal("people", w.Current()[0].Name())
+	s.NoError((<-events).Err)
+}
+
+func (s *WatcherTestSuite) TestReload_Malformed() {
+	// arrange.
+	w := s.watch()
+	s.writeRaw("tables: [")
+
+	// action.
+	err := w.Reload()
+
+	// assert.
+	s.Require().Error(err)
+	s.Equal("users", w.Current()[0].Name())
+}
+
+func (s *WatcherTestSuite) TestReload_RemovedFile() {
+	// arrange.
+	w := s.watch()
+	events := w.Subscribe()
+	s.Require().NoError(os.Remove(s.path))
+
+	// action.
+	err := w.Reload()
+
+	// assert.
+	s.Require().ErrorIs(err, os.ErrNotExist)
+	s.Equal("users", w.Current()[0].Name())
+	s.ErrorIs((<-events).Err, os.ErrNotExist)
+	s.Require().ErrorIs(w.Reload(), os.ErrNotExist)
+	s.Len(events, 0)
+}
+
+func (s *WatcherTestSuite) TestReload_Closed() {
+	// arrange.
+	w := s.watch()
+	events := w.Subscribe()
+	s.Require().NoError(w.Close())
+
+	// action.
+	err := w.Reload()
+
+	// assert.
+	s.Require().ErrorIs(err, morph.ErrWatcherClosed)
+	_, open := <-events
+	s.False(open)
+	_, open = <-w.Subscribe()
+	s.False(open)
+	s.NoError(w.Close())
+}
+
+func (s *WatcherTestSuite) TestPoll() {
+	// arrange.
+	w, err := morph.NewWatcher([]string{s.path}, morph.WithPollInterval(10*time.Millisecond))
+	s.Require().NoError(err)
+	defer w.Close()
+	events := w.Subscribe()
+
+	// action.
+	s.write("staging.users")
+
+	// assert.
+	select {
+	case event := <-events:
+		s.Require().NoError(event.Err)
+		s.Equal("staging.users", event.Tables[0].Name())
+		s.Equal("staging.users", w.Current()[0].Name())
+	case <-time.After(5 * time.Second):
+		s.Fail("timed out waiting for the watcher to reload")
+	}
+}
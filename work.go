@@ -0,0 +1,346 @@
+package morph
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// DefaultBatchSize is the default maximum number of rows inserted or deleted by a single statement.
+const DefaultBatchSize = 100
+
+// ErrUnmappedEntity represents an error encountered when tracking an entity that is not
+// mapped by any of the tables of a unit of work.
+var ErrUnmappedEntity = errors.New("morph: no table for entity")
+
+// TxBeginner represents the method of *sql.DB and *sql.Conn used to begin transactions.
+type TxBeginner interface {
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+// UnitOfWorkOption is a function that configures the unit of work options.
+type UnitOfWorkOption func(*UnitOfWorkOptions)
+
+// UnitOfWorkOptions represents the options used when saving a unit of work.
+type UnitOfWorkOptions struct {
+	// ExecutorOptions are the options of the executor used to execute the statements.
+	ExecutorOptions []ExecutorOption
+
+	// BatchSize is the maximum number of rows inserted or deleted by a single statement.
+	BatchSize int
+
+	// TxOptions are the options of the transaction the statements are executed within.
+	TxOptions *sql.TxOptions
+}
+
+// WithExecutorOptions executes the statements using an executor with the provided options.
+func WithExecutorOptions(options ...ExecutorOption) UnitOfWorkOption {
+	return func(o *UnitOfWorkOptions) {
+		o.ExecutorOptions = append(o.ExecutorOptions, options...)
+	}
+}
+
+// WithBatchSize inserts or deletes at most the provided number of rows with a single statement.
+func WithBatchSize(size int) UnitOfWorkOption {
+	return func(o *UnitOfWorkOptions) {
+		o.BatchSize = size
+	}
+}
+
+// WithTxOptions begins the transaction the statements are executed within using the provided options.
+func WithTxOptions(opts *sql.TxOptions) UnitOfWorkOption {
+	return func(o *UnitOfWorkOptions) {
+		o.TxOptions = opts
+	}
+}
+
+// UnitOfWork tracks the entities that are added, altered and removed across several tables,
+// and saves all of the changes within a single transaction.
+type UnitOfWork struct {
+	db      TxBeginner
+	tables  []Table
+	options UnitOfWorkOptions
+
+	// mu guards the tracked entities, which are indexed by table.
+	mu          sync.Mutex
+	additions   [][]any
+	alterations [][]any
+	removals    [][]any
+}
+
+// NewUnitOfWork constructs a unit of work for the entities mapped by the provided tables,
+// which are ordered so that parent tables precede the tables referencing them. It fails
+// when any of the tables are invalid or the dialect provided is not within Dialects.
+func NewUnitOfWork(db TxBeginner, tables []Table, options ...UnitOfWorkOption) (*UnitOfWork, error) {
+	u := &UnitOfWork{
+		db:      db,
+		tables:  append([]Table(nil), tables...),
+		options: UnitOfWorkOptions{BatchSize: DefaultBatchSize},
+	}
+	for _, opt := range options {
+		opt(&u.options)
+	}
+
+	for idx := range u.tables {
+		if err := u.tables[idx].validate(); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := NewExecutor(nil, u.options.ExecutorOptions...); err != nil {
+		return nil, err
+	}
+	if u.options.BatchSize < 1 {
+		u.options.BatchSize = DefaultBatchSize
+	}
+	u.reset()
+	return u, nil
+}
+
+// Add tracks the provided entities as new, so that they are inserted when saved. Entities
+// with generated keys must be provided as pointers so that their keys can be populated.
+func (u *UnitOfWork) Add(entities ...any) error {
+	return u.track(entities, func(idx int, entity any) {
+		if !contains(u.additions[idx], entity) {
+			u.additions[idx] = append(u.additions[idx], entity)
+		}
+	})
+}
+
+// Alter tracks the provided entities as dirty, so that they are updated when saved.
+// Entities that are already new are inserted as they are when saved instead.
+func (u *UnitOfWork) Alter(entities ...any) error {
+	return u.track(entities, func(idx int, entity any) {
+		if !contains(u.additions[idx], entity) && !contains(u.alterations[idx], entity) {
+			u.alterations[idx] = append(u.alterations[idx], entity)
+		}
+	})
+}
+
+// Remove tracks the provided entities as removed, so that they are deleted when saved.
+// Entities that are new are no longer inserted instead.
+func (u *UnitOfWork) Remove(entities ...any) error {
+	return u.track(entities, func(idx int, entity any) {
+		u.alterations[idx] = without(u.alterations[idx], entity)
+		if contains(u.additions[idx], entity) {
+			u.additions[idx] = without(u.additions[idx], entity)
+			return
+		}
+		if !contains(u.removals[idx], entity) {
+			u.removals[idx] = append(u.removals[idx], entity)
+		}
+	})
+}
+
+// Save executes the statements for the tracked entities within a single transaction.
+// Entities are inserted in table order, using a single statement for each batch of
+// entities that do not have generated keys, then updated, and finally deleted in
+// reverse table order using a single statement for each batch. The transaction is
+// rolled back on the first failure, in which case the entities remain tracked;
+// otherwise, they are no longer tracked once it is committed. When the context carries
+// an identity map, the entities added and altered are then kept, and those removed are not.
+func (u *UnitOfWork) Save(ctx context.Context) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	tx, err := u.db.BeginTx(ctx, u.options.TxOptions)
+	if err != nil {
+		return err
+	}
+	e, err := NewExecutor(tx, u.options.ExecutorOptions...)
+	if err == nil {
+		err = u.flush(ctx, e)
+	}
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return errors.Join(err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	u.remember(ctx)
+	u.reset()
+	return nil
+}
+
+// remember updates the identity map carried by the provided context, if any, with the
+// tracked entities, copying their values into the instances already kept for their rows.
+// Only pointers are kept, since other entities cannot be modified.
+func (u *UnitOfWork) remember(ctx context.Context) {
+	m, ok := IdentityMapFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	// the entities were evaluated when saved, so their keys are known to be valid.
+	for idx, t := range u.tables {
+		for _, entities := range [][]any{u.additions[idx], u.alterations[idx]} {
+			for _, entity := range entities {
+				if reflect.ValueOf(entity).Kind() == reflect.Ptr {
+					_ = m.refresh(t, entity)
+				}
+			}
+		}
+		for _, entity := range u.removals[idx] {
+			_ = m.Remove(t, entity)
+		}
+	}
+}
+
+// flush executes the statements for the tracked entities using the provided executor.
+func (u *UnitOfWork) flush(ctx context.Context, e *Executor) error {
+	for idx, t := range u.tables {
+		if err := u.insert(ctx, e, t, u.additions[idx]); err != nil {
+			return err
+		}
+	}
+	for idx, t := range u.tables {
+		for _, entity := range u.alterations[idx] {
+			if err := u.update(ctx, e, t, entity); err != nil {
+				return err
+			}
+		}
+	}
+	for idx := len(u.tables) - 1; idx >= 0; idx-- {
+		if err := u.delete(ctx, e, u.tables[idx], u.removals[idx]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insert inserts the provided entities into the table. Entities with generated keys are
+// inserted individually so that their keys can be populated.
+func (u *UnitOfWork) insert(ctx context.Context, e *Executor, t Table, entities []any) error {
+	batch := make([]any, 0, len(entities))
+	for _, entity := range entities {
+		_, generated, err := generatedKey(ctx, t, entity)
+		if err != nil {
+			return err
+		}
+		if generated {
+			if err := e.Insert(ctx, t, entity); err != nil {
+				return err
+			}
+			continue
+		}
+		batch = append(batch, entity)
+	}
+
+	return u.batches(batch, func(entities []any) error {
+		query, args, err := t.InsertRowsQueryWithArgs(entities, e.queryOptions(ctx)...)
+		if err != nil {
+			return err
+		}
+		return e.execRows(ctx, query, args, len(entities))
+	})
+}
+
+// update updates the row of the provided entity within the table. Like Repository.Save,
+// an update that does not affect any rows only fails when there is no row for the entity.
+func (u *UnitOfWork) update(ctx context.Context, e *Executor, t Table, entity any) error {
+	err := e.Update(ctx, t, entity)
+	if !errors.Is(err, ErrNoRowsAffected) {
+		return err
+	}
+	exists, existsErr := e.exists(ctx, t, entity)
+	if existsErr != nil {
+		return existsErr
+	}
+	if !exists {
+		return err
+	}
+	return nil
+}
+
+// delete deletes the rows of the provided entities from the table.
+func (u *UnitOfWork) delete(ctx context.Context, e *Executor, t Table, entities []any) error {
+	return u.batches(entities, func(entities []any) error {
+		query, args, err := t.DeleteByKeysQueryWithArgs(entities, e.queryOptions(ctx)...)
+		if err != nil {
+			return err
+		}
+		return e.execRows(ctx, query, args, len(entities))
+	})
+}
+
+// batches calls the provided function for each batch of the provided entities.
+func (u *UnitOfWork) batches(entities []any, fn func([]any) error) error {
+	for start := 0; start < len(entities); start += u.options.BatchSize {
+		end := min(start+u.options.BatchSize, len(entities))
+		if err := fn(entities[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// track calls the provided function with the index of the table mapping each of the
+// provided entities, failing without tracking any of them when any are unmapped.
+func (u *UnitOfWork) track(entities []any, fn func(idx int, entity any)) error {
+	indexes := make([]int, len(entities))
+	for i, entity := range entities {
+		idx := u.index(entity)
+		if idx < 0 {
+			return fmt.Errorf("%w of type %T", ErrUnmappedEntity, entity)
+		}
+		indexes[i] = idx
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	for i, entity := range entities {
+		fn(indexes[i], entity)
+	}
+	return nil
+}
+
+// index retrieves the index of the table mapping the provided entity, or -1 if there is none.
+func (u *UnitOfWork) index(entity any) int {
+	for idx := range u.tables {
+		if u.tables[idx].matchesType(entity) {
+			return idx
+		}
+	}
+	return -1
+}
+
+// reset stops tracking all entities.
+func (u *UnitOfWork) reset() {
+	u.additions = make([][]any, len(u.tables))
+	u.alterations = make([][]any, len(u.tables))
+	u.removals = make([][]any, len(u.tables))
+}
+
+// contains indicates if the provided entities contain the provided entity, which is
+// only the case for pointers, since entities are otherwise not identifiable.
+func contains(entities []any, entity any) bool {
+	if reflect.ValueOf(entity).Kind() != reflect.Ptr {
+		return false
+	}
+	for _, e := range entities {
+		if e == entity {
+			return true
+		}
+	}
+	return false
+}
+
+// without retrieves the provided entities, excluding the provided entity.
+func without(entities []any, entity any) []any {
+	if !contains(entities, entity) {
+		return entities
+	}
+	result := make([]any, 0, len(entities)-1)
+	for _, e := range entities {
+		if e != entity {
+			result = append(result, e)
+		}
+	}
+	return result
+}
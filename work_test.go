@@ -0,0 +1,266 @@
+package morph_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/freerware/morph"
+	"github.com/stretchr/testify/suite"
+	_ "modernc.org/sqlite"
+)
+
+// PurchaseOrder is an entity that is referenced by line items.
+type PurchaseOrder struct {
+	ID     int64
+	Status string
+}
+
+// workTables configures the tables of the entities used to test the unit of work, with
+// the parent tables preceding the tables referencing them.
+var workTables = morph.Configuration{Tables: []morph.TableConfiguration{
+	executorTables.Tables[0],
+	{
+		TypeName: "morph_test.PurchaseOrder",
+		Name:     "orders",
+		Alias:    "O",
+		Columns: []morph.ColumnConfiguration{
+			{Name: "id", Field: "ID", FieldStrategy: morph.FieldStrategyStructField, PrimaryKey: true},
+			{Name: "status", Field: "Status", FieldStrategy: morph.FieldStrategyStructField},
+		},
+	},
+	executorTables.Tables[1],
+}}
+
+type UnitOfWorkTestSuite struct {
+	suite.Suite
+
+	ctx    context.Context
+	db     *sql.DB
+	tables []morph.Table
+	sut    *morph.UnitOfWork
+}
+
+func TestUnitOfWorkTestSuite(t *testing.T) {
+	suite.Run(t, new(UnitOfWorkTestSuite))
+}
+
+func (s *UnitOfWorkTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.tables = morph.Must(workTables.AsMetadataStrict())
+
+	var err error
+	s.db, err = sql.Open("sqlite", ":memory:")
+	s.Require().NoError(err)
+	s.db.SetMaxOpenConns(1)
+	for _, statement := range []string{
+		"PRAGMA foreign_keys = ON",
+		"CREATE TABLE accounts (id INTEGER PRIMARY KEY AUTOINCREMENT, username TEXT NOT NULL, email TEXT, nickname TEXT)",
+		"CREATE TABLE orders (id INTEGER PRIMARY KEY, status TEXT NOT NULL)",
+		"CREATE TABLE line_items (order_id INTEGER NOT NULL REFERENCES orders (id), line INTEGER NOT NULL, quantity INTEGER NOT NULL, PRIMARY KEY (order_id, line))",
+		"INSERT INTO orders (id, status) VALUES (1, 'open'), (2, 'open')",
+		"INSERT INTO line_items (order_id, line, quantity) VALUES (1, 1, 5), (1, 2, 1), (2, 1, 3)",
+	} {
+		_, err := s.db.Exec(statement)
+		s.Require().NoError(err)
+	}
+
+	s.sut, err = morph.NewUnitOfWork(s.db, s.tables, morph.WithExecutorOptions(morph.WithDialect("sqlite")))
+	s.Require().NoError(err)
+}
+
+func (s *UnitOfWorkTestSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *UnitOfWorkTestSuite) SetupSubTest() {
+	s.TearDownTest()
+	s.SetupTest()
+}
+
+func (s *UnitOfWorkTestSuite) TestNewUnitOfWork_Errors() {
+	// action.
+	_, dialectErr := morph.NewUnitOfWork(s.db, s.tables, morph.WithExecutorOptions(morph.WithDialect("oracle")))
+	_, tableErr := morph.NewUnitOfWork(s.db, []morph.Table{{}})
+
+	// assert.
+	s.ErrorIs(dialectErr, morph.ErrUnsupportedDialect)
+	s.ErrorIs(tableErr, morph.ErrMissingTypeName)
+}
+
+func (s *UnitOfWorkTestSuite) TestSave() {
+	// arrange.
+	account := Account{Username: "fr33r"}
+	order := PurchaseOrder{ID: 3, Status: "open"}
+	items := []any{
+		&LineItem{OrderID: 3, Line: 1, Quantity: 2},
+		&LineItem{OrderID: 3, Line: 2, Quantity: 4},
+	}
+	s.Require().NoError(s.sut.Add(items...))
+	s.Require().NoError(s.sut.Add(&order, &account))
+	s.Require().NoError(s.sut.Alter(&PurchaseOrder{ID: 2, Status: "shipped"}, &LineItem{OrderID: 2, Line: 1, Quantity: 6}))
+	s.Require().NoError(s.sut.Remove(&LineItem{OrderID: 1, Line: 1}, &LineItem{OrderID: 1, Line: 2}, &PurchaseOrder{ID: 1}))
+
+	// action.
+	err := s.sut.Save(s.ctx)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal(int64(1), account.ID)
+	s.Equal([]string{"2:shipped", "3:open"}, s.orders())
+	s.Equal([]string{"2:1:6", "3:1:2", "3:2:4"}, s.items())
+	s.Require().NoError(s.sut.Save(s.ctx))
+	s.Equal([]string{"2:1:6", "3:1:2", "3:2:4"}, s.items())
+}
+
+func (s *UnitOfWorkTestSuite) TestSave_Batches() {
+	// arrange.
+	sut, err := morph.NewUnitOfWork(s.db, s.tables, morph.WithExecutorOptions(morph.WithDialect("sqlite")), morph.WithBatchSize(2))
+	s.Require().NoError(err)
+	for line := 2; line <= 6; line++ {
+		s.Require().NoError(sut.Add(&LineItem{OrderID: 2, Line: line, Quantity: line}))
+	}
+	s.Require().NoError(sut.Remove(&LineItem{OrderID: 1, Line: 1}, &LineItem{OrderID: 1, Line: 2}, &LineItem{OrderID: 2, Line: 1}))
+
+	// action.
+	err = sut.Save(s.ctx)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal([]string{"2:2:2", "2:3:3", "2:4:4", "2:5:5", "2:6:6"}, s.items())
+}
+
+func (s *UnitOfWorkTestSuite) TestSave_Rollback() {
+	tests := []struct {
+		name    string
+		prepare func()
+	}{
+		{
+			name: "Conflict",
+			prepare: func() {
+				s.Require().NoError(s.sut.Add(&PurchaseOrder{ID: 3, Status: "open"}, &LineItem{OrderID: 1, Line: 1, Quantity: 9}))
+			},
+		},
+		{
+			name: "ForeignKey",
+			prepare: func() {
+				s.Require().NoError(s.sut.Add(&PurchaseOrder{ID: 3, Status: "open"}))
+				s.Require().NoError(s.sut.Remove(&PurchaseOrder{ID: 1}))
+			},
+		},
+		{
+			name: "MissingRow",
+			prepare: func() {
+				s.Require().NoError(s.sut.Add(&PurchaseOrder{ID: 3, Status: "open"}))
+				s.Require().NoError(s.sut.Alter(&PurchaseOrder{ID: 404, Status: "lost"}))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// arrange.
+			test.prepare()
+
+			// action.
+			err := s.sut.Save(s.ctx)
+
+			// assert.
+			s.Error(err)
+			s.Equal([]string{"1:open", "2:open"}, s.orders())
+			s.Equal([]string{"1:1:5", "1:2:1", "2:1:3"}, s.items())
+		})
+	}
+}
+
+func (s *UnitOfWorkTestSuite) TestSave_IdentityMap() {
+	// arrange.
+	m := morph.NewIdentityMap()
+	_, err := m.Put(s.tables[1], &PurchaseOrder{ID: 1, Status: "open"})
+	s.Require().NoError(err)
+	added := PurchaseOrder{ID: 3, Status: "open"}
+	s.Require().NoError(s.sut.Add(&added, LineItem{OrderID: 3, Line: 1, Quantity: 1}))
+	s.Require().NoError(s.sut.Remove(&LineItem{OrderID: 1, Line: 1}, &LineItem{OrderID: 1, Line: 2}, &PurchaseOrder{ID: 1}))
+
+	// action.
+	err = s.sut.Save(morph.ContextWithIdentityMap(s.ctx, m))
+
+	// assert.
+	s.Require().NoError(err)
+	s.Equal(1, m.Len())
+	kept, found, err := m.Get(s.tables[1], 3)
+	s.Require().NoError(err)
+	s.True(found)
+	s.Same(&added, kept)
+}
+
+func (s *UnitOfWorkTestSuite) TestSave_IdentityMapRefreshed() {
+	// arrange.
+	m := morph.NewIdentityMap()
+	kept := &PurchaseOrder{ID: 2, Status: "open"}
+	_, err := m.Put(s.tables[1], kept)
+	s.Require().NoError(err)
+	s.Require().NoError(s.sut.Alter(&PurchaseOrder{ID: 2, Status: "shipped"}))
+
+	// action.
+	err = s.sut.Save(morph.ContextWithIdentityMap(s.ctx, m))
+
+	// assert.
+	s.Require().NoError(err)
+	found, _, err := m.Get(s.tables[1], 2)
+	s.Require().NoError(err)
+	s.Same(kept, found)
+	s.Equal("shipped", kept.Status)
+}
+
+func (s *UnitOfWorkTestSuite) TestRemove_Added() {
+	// arrange.
+	order := PurchaseOrder{ID: 3, Status: "open"}
+	s.Require().NoError(s.sut.Add(&order))
+	s.Require().NoError(s.sut.Alter(&order))
+
+	// action.
+	err := s.sut.Remove(&order)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Require().NoError(s.sut.Save(s.ctx))
+	s.Equal([]string{"1:open", "2:open"}, s.orders())
+}
+
+func (s *UnitOfWorkTestSuite) TestAdd_UnmappedEntity() {
+	// action.
+	err := s.sut.Add(&PurchaseOrder{ID: 3}, &TestModel{})
+
+	// assert.
+	s.ErrorIs(err, morph.ErrUnmappedEntity)
+	s.EqualError(err, "morph: no table for entity of type *morph_test.TestModel")
+	s.Require().NoError(s.sut.Save(s.ctx))
+	s.Equal([]string{"1:open", "2:open"}, s.orders())
+}
+
+// orders retrieves the ID and status of each order ordered by ID.
+func (s *UnitOfWorkTestSuite) orders() []string {
+	return s.strings("SELECT id || ':' || status FROM orders ORDER BY id")
+}
+
+// items retrieves the order ID, line and quantity of each line item ordered by key.
+func (s *UnitOfWorkTestSuite) items() []string {
+	return s.strings("SELECT order_id || ':' || line || ':' || quantity FROM line_items ORDER BY order_id, line")
+}
+
+// strings retrieves the single string column of the rows of the provided query.
+func (s *UnitOfWorkTestSuite) strings(query string) []string {
+	rows, err := s.db.QueryContext(s.ctx, query)
+	s.Require().NoError(err)
+	defer rows.Close()
+
+	values := []string{}
+	for rows.Next() {
+		var value string
+		s.Require().NoError(rows.Scan(&value))
+		values = append(values, value)
+	}
+	s.Require().NoError(rows.Err())
+	return values
+}
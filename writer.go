@@ -0,0 +1,92 @@
+package morph
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	// Writers is the collection of writers by file extension. Modifying Writers
+	// directly is not safe while saving concurrently, so prefer RegisterWriter.
+	Writers = map[string]Writer{
+		"yaml": YAMLWriter{},
+		"yml":  YAMLWriter{},
+		"json": JSONWriter{},
+	}
+
+	// writersMu guards concurrent access to Writers.
+	writersMu sync.RWMutex
+)
+
+// RegisterWriter registers the writer for files with the provided extension, replacing
+// any writer previously registered for it. Providing a nil writer removes the registration.
+// It is safe for concurrent use.
+func RegisterWriter(ext string, writer Writer) {
+	writersMu.Lock()
+	defer writersMu.Unlock()
+	ext = strings.ToLower(strings.TrimPrefix(ext, "."))
+	if writer == nil {
+		delete(Writers, ext)
+		return
+	}
+	Writers[ext] = writer
+}
+
+// Save writes the configuration to the provided file, using the writer registered
+// for the file extension.
+func Save(path string, c Configuration) error {
+	ext := extension(path)
+	writer, ok := lookupWriter(ext)
+	if !ok {
+		return fmt.Errorf("morph: no writer for files with %q extension", ext)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := writer.Write(f, c); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// lookupWriter retrieves the writer registered for the provided file extension.
+func lookupWriter(extension string) (Writer, bool) {
+	writersMu.RLock()
+	defer writersMu.RUnlock()
+	writer, ok := Writers[extension]
+	return writer, ok
+}
+
+// Writer writes the configuration to the provided writer.
+type Writer interface {
+	Write(w io.Writer, c Configuration) error
+}
+
+type JSONWriter struct{}
+
+// Write writes the configuration to the provided writer as indented JSON.
+func (JSONWriter) Write(w io.Writer, c Configuration) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(c)
+}
+
+type YAMLWriter struct{}
+
+// Write writes the configuration to the provided writer as YAML.
+func (YAMLWriter) Write(w io.Writer, c Configuration) error {
+	enc := yaml.NewEncoder(w)
+	if err := enc.Encode(c); err != nil {
+		return err
+	}
+	return enc.Close()
+}
@@ -0,0 +1,169 @@
+package morph_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/freerware/morph"
+	"github.com/stretchr/testify/suite"
+)
+
+type WriterTestSuite struct {
+	suite.Suite
+
+	config morph.Configuration
+}
+
+// writerFunc adapts a function to a writer.
+type writerFunc func(w io.Writer, c morph.Configuration) error
+
+func (f writerFunc) Write(w io.Writer, c morph.Configuration) error {
+	return f(w, c)
+}
+
+func TestWriterTestSuite(t *testing.T) {
+	suite.Run(t, new(WriterTestSuite))
+}
+
+func (s *WriterTestSuite) SetupTest() {
+	table, err := morph.Reflect(&AnotherTestModel{})
+	s.Require().NoError(err)
+	s.config = morph.Configuration{}
+	s.config.FromTables(table)
+}
+
+func (s *WriterTestSuite) TestJSONWriter_Write() {
+	// arrange.
+	buf := new(bytes.Buffer)
+
+	// action.
+	err := morph.JSONWriter{}.Write(buf, s.config)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Contains(buf.String(), "\n      \"typeName\": \"*morph_test.AnotherTestModel\",\n")
+	actual, err := morph.JSONLoader{}.LoadBytes(buf.Bytes())
+	s.Require().NoError(err)
+	s.Equal(s.config.Tables, actual.Tables)
+}
+
+func (s *WriterTestSuite) TestYAMLWriter_Write() {
+	// arrange.
+	buf := new(bytes.Buffer)
+
+	// action.
+	err := morph.YAMLWriter{}.Write(buf, s.config)
+
+	// assert.
+	s.Require().NoError(err)
+	s.Contains(buf.String(), "- typeName: '*morph_test.AnotherTestModel'\n")
+	s.NotContains(buf.String(), "overrides")
+	actual, err := morph.YAMLLoader{}.LoadBytes(buf.Bytes())
+	s.Require().NoError(err)
+	s.Equal(s.config.Tables, actual.Tables)
+}
+
+func (s *WriterTestSuite) TestSave() {
+	for _, ext := range []string{"json", "yaml", "yml"} {
+		s.Run(ext, func() {
+			// arrange.
+			path := filepath.Join(s.T().TempDir(), "mappings."+ext)
+
+			// action.
+			err := morph.Save(path, s.config)
+
+			// assert.
+			s.Require().NoError(err)
+			actual, err := morph.Load(path)
+			s.Require().NoError(err)
+			s.Equal(s.config.Tables, actual.Tables)
+		})
+	}
+}
+
+func (s *WriterTestSuite) TestRegisterWriter() {
+	// arrange.
+	path := filepath.Join(s.T().TempDir(), "mappings.XML")
+	writer := writerFunc(func(w io.Writer, c morph.Configuration) error {
+		_, err := io.WriteString(w, "<tables/>")
+		return err
+	})
+	morph.RegisterWriter(".xml", writer)
+	defer morph.RegisterWriter("xml", nil)
+
+	// action.
+	err := morph.Save(path, s.config)
+
+	// assert.
+	s.Require().NoError(err)
+	data, err := os.ReadFile(path)
+	s.Require().NoError(err)
+	s.Equal("<tables/>", string(data))
+}
+
+func (s *WriterTestSuite) TestRegisterWriter_Remove() {
+	// arrange.
+	path := filepath.Join(s.T().TempDir(), "mappings.xml")
+	morph.RegisterWriter("xml", morph.YAMLWriter{})
+
+	// action.
+	morph.RegisterWriter("xml", nil)
+	err := morph.Save(path, s.config)
+
+	// assert.
+	s.Require().Error(err)
+	s.NoFileExists(path)
+}
+
+func (s *WriterTestSuite) TestRegisterWriter_Concurrent() {
+	// arrange.
+	var wg sync.WaitGroup
+	dir := s.T().TempDir()
+	defer morph.RegisterWriter("xml", nil)
+
+	// action.
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			morph.RegisterWriter("xml", morph.YAMLWriter{})
+		}()
+		go func(i int) {
+			defer wg.Done()
+			morph.Save(filepath.Join(dir, fmt.Sprintf("mappings%d.xml", i)), s.config)
+		}(i)
+	}
+	wg.Wait()
+
+	// assert.
+	morph.RegisterWriter("xml", morph.YAMLWriter{})
+	s.Require().NoError(morph.Save(filepath.Join(dir, "mappings.xml"), s.config))
+}
+
+func (s *WriterTestSuite) TestSave_MissingWriter() {
+	// arrange.
+	path := filepath.Join(s.T().TempDir(), "mappings.txt")
+
+	// action.
+	err := morph.Save(path, s.config)
+
+	// assert.
+	s.Require().Error(err)
+	s.NoFileExists(path)
+}
+
+func (s *WriterTestSuite) TestSave_MissingDirectory() {
+	// arrange.
+	path := filepath.Join(s.T().TempDir(), "missing", "mappings.json")
+
+	// action.
+	err := morph.Save(path, s.config)
+
+	// assert.
+	s.Require().Error(err)
+}